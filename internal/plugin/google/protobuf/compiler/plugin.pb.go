@@ -1,41 +1,152 @@
-// Code generated by protoc-gen-gogo.
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: google/protobuf/compiler/plugin.proto
-// DO NOT EDIT!
 
-/*
-Package google_protobuf_compiler is a generated protocol buffer package.
+package pluginpb
 
-It is generated from these files:
-	google/protobuf/compiler/plugin.proto
-
-It has these top-level messages:
-	CodeGeneratorRequest
-	CodeGeneratorResponse
-*/
-package google_protobuf_compiler
-
-import proto "github.com/gogo/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import google_protobuf "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	protobuf "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+	math "math"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Sync with code_generator.h.
+type CodeGeneratorResponse_Feature int32
+
+const (
+	CodeGeneratorResponse_FEATURE_NONE              CodeGeneratorResponse_Feature = 0
+	CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL   CodeGeneratorResponse_Feature = 1
+	CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS CodeGeneratorResponse_Feature = 2
+)
+
+var CodeGeneratorResponse_Feature_name = map[int32]string{
+	0: "FEATURE_NONE",
+	1: "FEATURE_PROTO3_OPTIONAL",
+	2: "FEATURE_SUPPORTS_EDITIONS",
+}
+
+var CodeGeneratorResponse_Feature_value = map[string]int32{
+	"FEATURE_NONE":              0,
+	"FEATURE_PROTO3_OPTIONAL":   1,
+	"FEATURE_SUPPORTS_EDITIONS": 2,
+}
+
+func (x CodeGeneratorResponse_Feature) Enum() *CodeGeneratorResponse_Feature {
+	p := new(CodeGeneratorResponse_Feature)
+	*p = x
+	return p
+}
+
+func (x CodeGeneratorResponse_Feature) String() string {
+	return proto.EnumName(CodeGeneratorResponse_Feature_name, int32(x))
+}
+
+func (x *CodeGeneratorResponse_Feature) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(CodeGeneratorResponse_Feature_value, data, "CodeGeneratorResponse_Feature")
+	if err != nil {
+		return err
+	}
+	*x = CodeGeneratorResponse_Feature(value)
+	return nil
+}
+
+func (CodeGeneratorResponse_Feature) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3562add825dafed5, []int{2, 0}
+}
+
+// The version number of protocol compiler.
+type Version struct {
+	Major *int32 `protobuf:"varint,1,opt,name=major" json:"major,omitempty"`
+	Minor *int32 `protobuf:"varint,2,opt,name=minor" json:"minor,omitempty"`
+	Patch *int32 `protobuf:"varint,3,opt,name=patch" json:"patch,omitempty"`
+	// A suffix for alpha, beta or rc release, e.g., "alpha-1", "rc2". It should
+	// be empty for mainline stable releases.
+	Suffix               *string  `protobuf:"bytes,4,opt,name=suffix" json:"suffix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Version) Reset()         { *m = Version{} }
+func (m *Version) String() string { return proto.CompactTextString(m) }
+func (*Version) ProtoMessage()    {}
+func (*Version) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3562add825dafed5, []int{0}
+}
+func (m *Version) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Version.Unmarshal(m, b)
+}
+func (m *Version) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Version.Marshal(b, m, deterministic)
+}
+func (m *Version) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Version.Merge(m, src)
+}
+func (m *Version) XXX_Size() int {
+	return xxx_messageInfo_Version.Size(m)
+}
+func (m *Version) XXX_DiscardUnknown() {
+	xxx_messageInfo_Version.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Version proto.InternalMessageInfo
+
+func (m *Version) GetMajor() int32 {
+	if m != nil && m.Major != nil {
+		return *m.Major
+	}
+	return 0
+}
+
+func (m *Version) GetMinor() int32 {
+	if m != nil && m.Minor != nil {
+		return *m.Minor
+	}
+	return 0
+}
+
+func (m *Version) GetPatch() int32 {
+	if m != nil && m.Patch != nil {
+		return *m.Patch
+	}
+	return 0
+}
+
+func (m *Version) GetSuffix() string {
+	if m != nil && m.Suffix != nil {
+		return *m.Suffix
+	}
+	return ""
+}
+
 // An encoded CodeGeneratorRequest is written to the plugin's stdin.
 type CodeGeneratorRequest struct {
 	// The .proto files that were explicitly listed on the command-line.  The
 	// code generator should generate code only for these files.  Each file's
 	// descriptor will be included in proto_file, below.
-	FileToGenerate []string `protobuf:"bytes,1,rep,name=file_to_generate" json:"file_to_generate,omitempty"`
+	FileToGenerate []string `protobuf:"bytes,1,rep,name=file_to_generate,json=fileToGenerate" json:"file_to_generate,omitempty"`
 	// The generator parameter passed on the command-line.
 	Parameter *string `protobuf:"bytes,2,opt,name=parameter" json:"parameter,omitempty"`
 	// FileDescriptorProtos for all files in files_to_generate and everything
 	// they import.  The files will appear in topological order, so each file
 	// appears before any file that imports it.
 	//
+	// Note: the files listed in files_to_generate will include runtime-retention
+	// options only, but all other files will include source-retention options.
+	// The source_file_descriptors field below is available in case you need
+	// source-retention options for files_to_generate.
+	//
 	// protoc guarantees that all proto_files will be written after
 	// the fields above, even though this is not technically guaranteed by the
 	// protobuf wire format.  This theoretically could allow a plugin to stream
@@ -43,13 +154,44 @@ type CodeGeneratorRequest struct {
 	// the entire set into memory at once.  However, as of this writing, this
 	// is not similarly optimized on protoc's end -- it will store all fields in
 	// memory at once before sending them to the plugin.
-	ProtoFile        []*google_protobuf.FileDescriptorProto `protobuf:"bytes,15,rep,name=proto_file" json:"proto_file,omitempty"`
-	XXX_unrecognized []byte                                 `json:"-"`
+	//
+	// Type names of fields and extensions in the FileDescriptorProto are always
+	// fully qualified.
+	ProtoFile []*protobuf.FileDescriptorProto `protobuf:"bytes,15,rep,name=proto_file,json=protoFile" json:"proto_file,omitempty"`
+	// File descriptors with all options, including source-retention options.
+	// These descriptors are only provided for the files listed in
+	// files_to_generate.
+	SourceFileDescriptors []*protobuf.FileDescriptorProto `protobuf:"bytes,17,rep,name=source_file_descriptors,json=sourceFileDescriptors" json:"source_file_descriptors,omitempty"`
+	// The version number of protocol compiler.
+	CompilerVersion      *Version `protobuf:"bytes,3,opt,name=compiler_version,json=compilerVersion" json:"compiler_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CodeGeneratorRequest) Reset()         { *m = CodeGeneratorRequest{} }
 func (m *CodeGeneratorRequest) String() string { return proto.CompactTextString(m) }
 func (*CodeGeneratorRequest) ProtoMessage()    {}
+func (*CodeGeneratorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3562add825dafed5, []int{1}
+}
+func (m *CodeGeneratorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CodeGeneratorRequest.Unmarshal(m, b)
+}
+func (m *CodeGeneratorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CodeGeneratorRequest.Marshal(b, m, deterministic)
+}
+func (m *CodeGeneratorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeGeneratorRequest.Merge(m, src)
+}
+func (m *CodeGeneratorRequest) XXX_Size() int {
+	return xxx_messageInfo_CodeGeneratorRequest.Size(m)
+}
+func (m *CodeGeneratorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeGeneratorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeGeneratorRequest proto.InternalMessageInfo
 
 func (m *CodeGeneratorRequest) GetFileToGenerate() []string {
 	if m != nil {
@@ -65,13 +207,27 @@ func (m *CodeGeneratorRequest) GetParameter() string {
 	return ""
 }
 
-func (m *CodeGeneratorRequest) GetProtoFile() []*google_protobuf.FileDescriptorProto {
+func (m *CodeGeneratorRequest) GetProtoFile() []*protobuf.FileDescriptorProto {
 	if m != nil {
 		return m.ProtoFile
 	}
 	return nil
 }
 
+func (m *CodeGeneratorRequest) GetSourceFileDescriptors() []*protobuf.FileDescriptorProto {
+	if m != nil {
+		return m.SourceFileDescriptors
+	}
+	return nil
+}
+
+func (m *CodeGeneratorRequest) GetCompilerVersion() *Version {
+	if m != nil {
+		return m.CompilerVersion
+	}
+	return nil
+}
+
 // The plugin writes an encoded CodeGeneratorResponse to stdout.
 type CodeGeneratorResponse struct {
 	// Error message.  If non-empty, code generation failed.  The plugin process
@@ -82,14 +238,49 @@ type CodeGeneratorResponse struct {
 	// problem in protoc itself -- such as the input CodeGeneratorRequest being
 	// unparseable -- should be reported by writing a message to stderr and
 	// exiting with a non-zero status code.
-	Error            *string                       `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
-	File             []*CodeGeneratorResponse_File `protobuf:"bytes,15,rep,name=file" json:"file,omitempty"`
-	XXX_unrecognized []byte                        `json:"-"`
+	Error *string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	// A bitmask of supported features that the code generator supports.
+	// This is a bitwise "or" of values from the Feature enum.
+	SupportedFeatures *uint64 `protobuf:"varint,2,opt,name=supported_features,json=supportedFeatures" json:"supported_features,omitempty"`
+	// The minimum edition this plugin supports.  This will be treated as an
+	// Edition enum, but we want to allow unknown values.  It should be specified
+	// according the edition enum value, *not* the edition number.  Only takes
+	// effect for plugins that have FEATURE_SUPPORTS_EDITIONS set.
+	MinimumEdition *int32 `protobuf:"varint,3,opt,name=minimum_edition,json=minimumEdition" json:"minimum_edition,omitempty"`
+	// The maximum edition this plugin supports.  This will be treated as an
+	// Edition enum, but we want to allow unknown values.  It should be specified
+	// according the edition enum value, *not* the edition number.  Only takes
+	// effect for plugins that have FEATURE_SUPPORTS_EDITIONS set.
+	MaximumEdition       *int32                        `protobuf:"varint,4,opt,name=maximum_edition,json=maximumEdition" json:"maximum_edition,omitempty"`
+	File                 []*CodeGeneratorResponse_File `protobuf:"bytes,15,rep,name=file" json:"file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
 func (m *CodeGeneratorResponse) Reset()         { *m = CodeGeneratorResponse{} }
 func (m *CodeGeneratorResponse) String() string { return proto.CompactTextString(m) }
 func (*CodeGeneratorResponse) ProtoMessage()    {}
+func (*CodeGeneratorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3562add825dafed5, []int{2}
+}
+func (m *CodeGeneratorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CodeGeneratorResponse.Unmarshal(m, b)
+}
+func (m *CodeGeneratorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CodeGeneratorResponse.Marshal(b, m, deterministic)
+}
+func (m *CodeGeneratorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeGeneratorResponse.Merge(m, src)
+}
+func (m *CodeGeneratorResponse) XXX_Size() int {
+	return xxx_messageInfo_CodeGeneratorResponse.Size(m)
+}
+func (m *CodeGeneratorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeGeneratorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeGeneratorResponse proto.InternalMessageInfo
 
 func (m *CodeGeneratorResponse) GetError() string {
 	if m != nil && m.Error != nil {
@@ -98,6 +289,27 @@ func (m *CodeGeneratorResponse) GetError() string {
 	return ""
 }
 
+func (m *CodeGeneratorResponse) GetSupportedFeatures() uint64 {
+	if m != nil && m.SupportedFeatures != nil {
+		return *m.SupportedFeatures
+	}
+	return 0
+}
+
+func (m *CodeGeneratorResponse) GetMinimumEdition() int32 {
+	if m != nil && m.MinimumEdition != nil {
+		return *m.MinimumEdition
+	}
+	return 0
+}
+
+func (m *CodeGeneratorResponse) GetMaximumEdition() int32 {
+	if m != nil && m.MaximumEdition != nil {
+		return *m.MaximumEdition
+	}
+	return 0
+}
+
 func (m *CodeGeneratorResponse) GetFile() []*CodeGeneratorResponse_File {
 	if m != nil {
 		return m.File
@@ -156,15 +368,41 @@ type CodeGeneratorResponse_File struct {
 	// command line.
 	//
 	// If |insertion_point| is present, |name| must also be present.
-	InsertionPoint *string `protobuf:"bytes,2,opt,name=insertion_point" json:"insertion_point,omitempty"`
+	InsertionPoint *string `protobuf:"bytes,2,opt,name=insertion_point,json=insertionPoint" json:"insertion_point,omitempty"`
 	// The file contents.
-	Content          *string `protobuf:"bytes,15,opt,name=content" json:"content,omitempty"`
-	XXX_unrecognized []byte  `json:"-"`
+	Content *string `protobuf:"bytes,15,opt,name=content" json:"content,omitempty"`
+	// Information describing the file content being inserted. If an insertion
+	// point is used, this information will be appropriately offset and inserted
+	// into the code generation metadata for the generated files.
+	GeneratedCodeInfo    *protobuf.GeneratedCodeInfo `protobuf:"bytes,16,opt,name=generated_code_info,json=generatedCodeInfo" json:"generated_code_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
 func (m *CodeGeneratorResponse_File) Reset()         { *m = CodeGeneratorResponse_File{} }
 func (m *CodeGeneratorResponse_File) String() string { return proto.CompactTextString(m) }
 func (*CodeGeneratorResponse_File) ProtoMessage()    {}
+func (*CodeGeneratorResponse_File) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3562add825dafed5, []int{2, 0}
+}
+func (m *CodeGeneratorResponse_File) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CodeGeneratorResponse_File.Unmarshal(m, b)
+}
+func (m *CodeGeneratorResponse_File) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CodeGeneratorResponse_File.Marshal(b, m, deterministic)
+}
+func (m *CodeGeneratorResponse_File) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeGeneratorResponse_File.Merge(m, src)
+}
+func (m *CodeGeneratorResponse_File) XXX_Size() int {
+	return xxx_messageInfo_CodeGeneratorResponse_File.Size(m)
+}
+func (m *CodeGeneratorResponse_File) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeGeneratorResponse_File.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeGeneratorResponse_File proto.InternalMessageInfo
 
 func (m *CodeGeneratorResponse_File) GetName() string {
 	if m != nil && m.Name != nil {
@@ -186,3 +424,64 @@ func (m *CodeGeneratorResponse_File) GetContent() string {
 	}
 	return ""
 }
+
+func (m *CodeGeneratorResponse_File) GetGeneratedCodeInfo() *protobuf.GeneratedCodeInfo {
+	if m != nil {
+		return m.GeneratedCodeInfo
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("google.protobuf.compiler.CodeGeneratorResponse_Feature", CodeGeneratorResponse_Feature_name, CodeGeneratorResponse_Feature_value)
+	proto.RegisterType((*Version)(nil), "google.protobuf.compiler.Version")
+	proto.RegisterType((*CodeGeneratorRequest)(nil), "google.protobuf.compiler.CodeGeneratorRequest")
+	proto.RegisterType((*CodeGeneratorResponse)(nil), "google.protobuf.compiler.CodeGeneratorResponse")
+	proto.RegisterType((*CodeGeneratorResponse_File)(nil), "google.protobuf.compiler.CodeGeneratorResponse.File")
+}
+
+func init() {
+	proto.RegisterFile("google/protobuf/compiler/plugin.proto", fileDescriptor_3562add825dafed5)
+}
+
+var fileDescriptor_3562add825dafed5 = []byte{
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6e, 0xd3, 0x4c,
+	0x10, 0xfd, 0x92, 0xba, 0x5f, 0x95, 0x6d, 0xd5, 0xb8, 0x4b, 0x4b, 0x4d, 0x29, 0x52, 0x88, 0x40,
+	0x84, 0x0b, 0x5c, 0xa9, 0xf0, 0x02, 0xfd, 0x49, 0x4b, 0xa5, 0xaa, 0xb1, 0xb6, 0x29, 0x48, 0x08,
+	0x69, 0xe5, 0xda, 0x63, 0xb3, 0x28, 0xde, 0x31, 0xbb, 0x6b, 0x54, 0x1e, 0x80, 0x97, 0xe1, 0x8e,
+	0x27, 0xe1, 0x95, 0x90, 0xd7, 0xeb, 0xfe, 0x04, 0x7a, 0xc1, 0x5d, 0xe6, 0x9c, 0xe3, 0xc9, 0xcc,
+	0x39, 0xa3, 0x25, 0xcf, 0x73, 0xc4, 0x7c, 0x06, 0x3b, 0xa5, 0x42, 0x83, 0x97, 0x55, 0xb6, 0x93,
+	0x60, 0x51, 0x8a, 0x19, 0xa8, 0x9d, 0x72, 0x56, 0xe5, 0x42, 0x86, 0x96, 0xa0, 0x41, 0x23, 0x0b,
+	0x5b, 0x59, 0xd8, 0xca, 0xb6, 0x06, 0xf3, 0x0d, 0x52, 0xd0, 0x89, 0x12, 0xa5, 0x41, 0xd5, 0xa8,
+	0x87, 0x09, 0x59, 0x7a, 0x07, 0x4a, 0x0b, 0x94, 0x74, 0x9d, 0x2c, 0x16, 0xf1, 0x67, 0x54, 0x41,
+	0x67, 0xd0, 0x19, 0x2d, 0xb2, 0xa6, 0xb0, 0xa8, 0x90, 0xa8, 0x82, 0xae, 0x43, 0x85, 0x6c, 0xd0,
+	0x32, 0x36, 0xc9, 0xa7, 0x60, 0xa1, 0x41, 0x6d, 0x41, 0x1f, 0x92, 0xff, 0x75, 0x95, 0x65, 0xe2,
+	0x2a, 0xf0, 0x06, 0x9d, 0x51, 0x8f, 0xb9, 0x6a, 0xf8, 0xab, 0x4b, 0xd6, 0x0f, 0x30, 0x85, 0x63,
+	0x90, 0xa0, 0x62, 0x83, 0x8a, 0xc1, 0x97, 0x0a, 0xb4, 0xa1, 0x23, 0xe2, 0x67, 0x62, 0x06, 0xdc,
+	0x20, 0xcf, 0x1b, 0x0e, 0x82, 0xce, 0x60, 0x61, 0xd4, 0x63, 0xab, 0x35, 0x3e, 0x45, 0xf7, 0x05,
+	0xd0, 0x6d, 0xd2, 0x2b, 0x63, 0x15, 0x17, 0x60, 0xa0, 0x19, 0xa5, 0xc7, 0x6e, 0x00, 0x7a, 0x40,
+	0x88, 0x5d, 0x87, 0xd7, 0x5f, 0x05, 0xfd, 0xc1, 0xc2, 0x68, 0x79, 0xf7, 0x59, 0x38, 0x6f, 0xcb,
+	0x91, 0x98, 0xc1, 0xe1, 0xb5, 0x01, 0x51, 0x0d, 0xb3, 0x9e, 0x65, 0x6b, 0x86, 0x7e, 0x24, 0x9b,
+	0x1a, 0x2b, 0x95, 0x80, 0xed, 0xc2, 0x6f, 0xac, 0xd2, 0xc1, 0xda, 0x3f, 0x74, 0xdc, 0x68, 0x9a,
+	0xdc, 0xa5, 0x34, 0x3d, 0x25, 0x7e, 0x1b, 0x0b, 0xff, 0xda, 0x38, 0x6e, 0xcd, 0x5b, 0xde, 0x7d,
+	0x1a, 0xde, 0x97, 0x5f, 0xe8, 0xa2, 0x61, 0xfd, 0x16, 0x71, 0xc0, 0xf0, 0xbb, 0x47, 0x36, 0xe6,
+	0x1c, 0xd5, 0x25, 0x4a, 0x0d, 0x75, 0x32, 0xa0, 0x94, 0x4b, 0xb1, 0xc7, 0x9a, 0x82, 0xbe, 0x22,
+	0x54, 0x57, 0x65, 0x89, 0xca, 0x40, 0xca, 0x33, 0x88, 0x4d, 0xa5, 0x40, 0x5b, 0x1f, 0x3d, 0xb6,
+	0x76, 0xcd, 0x1c, 0x39, 0x82, 0xbe, 0x20, 0xfd, 0x42, 0x48, 0x51, 0x54, 0x05, 0x87, 0x54, 0x98,
+	0x76, 0xd6, 0x45, 0xb6, 0xea, 0xe0, 0x71, 0x83, 0x5a, 0x61, 0x7c, 0x75, 0x47, 0xe8, 0x39, 0x61,
+	0x7c, 0x75, 0x5b, 0xf8, 0x96, 0x78, 0xb7, 0xb2, 0x79, 0x73, 0xff, 0xca, 0x7f, 0xdd, 0xca, 0x1a,
+	0xcd, 0x6c, 0x87, 0xad, 0x9f, 0x1d, 0xe2, 0xd9, 0xbc, 0x28, 0xf1, 0x64, 0x5c, 0x80, 0x5b, 0xd4,
+	0xfe, 0xae, 0xe7, 0x11, 0x52, 0x83, 0xaa, 0xff, 0x93, 0x97, 0x28, 0xa4, 0x71, 0xc7, 0xb2, 0x7a,
+	0x0d, 0x47, 0x35, 0x4a, 0x03, 0xb2, 0x94, 0xa0, 0x34, 0x20, 0x4d, 0xd0, 0xb7, 0x82, 0xb6, 0xa4,
+	0x8c, 0x3c, 0x68, 0x6f, 0x31, 0xe5, 0x09, 0xa6, 0xc0, 0x85, 0xcc, 0x30, 0xf0, 0x6d, 0x56, 0xc3,
+	0x3f, 0x06, 0x6f, 0x2f, 0x34, 0xad, 0x07, 0x3f, 0x91, 0x19, 0xb2, 0xb5, 0x7c, 0x1e, 0x1a, 0xbe,
+	0x27, 0x4b, 0xce, 0x5b, 0xea, 0x93, 0x95, 0xa3, 0xf1, 0xde, 0xf4, 0x82, 0x8d, 0xf9, 0xd9, 0xe4,
+	0x6c, 0xec, 0xff, 0x47, 0x1f, 0x93, 0xcd, 0x16, 0x89, 0xd8, 0x64, 0x3a, 0x79, 0xcd, 0x27, 0xd1,
+	0xf4, 0x64, 0x72, 0xb6, 0x77, 0xea, 0x77, 0xe8, 0x13, 0xf2, 0xa8, 0x25, 0xcf, 0x2f, 0xa2, 0x68,
+	0xc2, 0xa6, 0xe7, 0x7c, 0x7c, 0x78, 0x52, 0xf3, 0xe7, 0x7e, 0x77, 0x5f, 0x91, 0xed, 0x04, 0x8b,
+	0x7b, 0xdd, 0xdc, 0x5f, 0x89, 0xec, 0x43, 0x61, 0x2f, 0x53, 0x7f, 0x78, 0xe9, 0x74, 0x39, 0xce,
+	0x62, 0x99, 0x87, 0xa8, 0xf2, 0x9b, 0x97, 0xc1, 0x7c, 0x2b, 0x41, 0xbb, 0x77, 0xa5, 0xbc, 0xfc,
+	0xd1, 0x0d, 0x8e, 0x1b, 0x6d, 0xd4, 0xf6, 0x3c, 0x70, 0x3d, 0x7f, 0x0f, 0x00, 0xf4, 0x30, 0x1b,
+	0x5e, 0x96, 0x04, 0x00, 0x00,
+}
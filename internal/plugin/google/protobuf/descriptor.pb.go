@@ -1,44 +1,153 @@
-// Code generated by protoc-gen-gogo.
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: google/protobuf/descriptor.proto
-// DO NOT EDIT!
-
-/*
-Package google_protobuf is a generated protocol buffer package.
-
-It is generated from these files:
-	google/protobuf/descriptor.proto
-
-It has these top-level messages:
-	FileDescriptorSet
-	FileDescriptorProto
-	DescriptorProto
-	FieldDescriptorProto
-	OneofDescriptorProto
-	EnumDescriptorProto
-	EnumValueDescriptorProto
-	ServiceDescriptorProto
-	MethodDescriptorProto
-	FileOptions
-	MessageOptions
-	FieldOptions
-	EnumOptions
-	EnumValueOptions
-	ServiceOptions
-	MethodOptions
-	UninterpretedOption
-	SourceCodeInfo
-*/
-package google_protobuf
-
-import proto "github.com/gogo/protobuf/proto"
-import fmt "fmt"
-import math "math"
+
+package descriptorpb
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The full set of known editions.
+type Edition int32
+
+const (
+	// A placeholder for an unknown edition value.
+	Edition_EDITION_UNKNOWN Edition = 0
+	// A placeholder edition for specifying default behaviors *before* a feature
+	// was first introduced.  This is effectively an "infinite past".
+	Edition_EDITION_LEGACY Edition = 900
+	// Legacy syntax "editions".  These pre-date editions, but behave much like
+	// distinct editions.  These can't be used to specify the edition of proto
+	// files, but feature definitions must supply proto2/proto3 defaults for
+	// backwards compatibility.
+	Edition_EDITION_PROTO2 Edition = 998
+	Edition_EDITION_PROTO3 Edition = 999
+	// Editions that have been released.  The specific values are arbitrary and
+	// should not be depended on, but they will always be time-ordered for easy
+	// comparison.
+	Edition_EDITION_2023 Edition = 1000
+	Edition_EDITION_2024 Edition = 1001
+	// Placeholder editions for testing feature resolution.  These should not be
+	// used or relyed on outside of tests.
+	Edition_EDITION_1_TEST_ONLY     Edition = 1
+	Edition_EDITION_2_TEST_ONLY     Edition = 2
+	Edition_EDITION_99997_TEST_ONLY Edition = 99997
+	Edition_EDITION_99998_TEST_ONLY Edition = 99998
+	Edition_EDITION_99999_TEST_ONLY Edition = 99999
+	// Placeholder for specifying unbounded edition support.  This should only
+	// ever be used by plugins that can expect to never require any changes to
+	// support a new edition.
+	Edition_EDITION_MAX Edition = 2147483647
+)
+
+var Edition_name = map[int32]string{
+	0:          "EDITION_UNKNOWN",
+	900:        "EDITION_LEGACY",
+	998:        "EDITION_PROTO2",
+	999:        "EDITION_PROTO3",
+	1000:       "EDITION_2023",
+	1001:       "EDITION_2024",
+	1:          "EDITION_1_TEST_ONLY",
+	2:          "EDITION_2_TEST_ONLY",
+	99997:      "EDITION_99997_TEST_ONLY",
+	99998:      "EDITION_99998_TEST_ONLY",
+	99999:      "EDITION_99999_TEST_ONLY",
+	2147483647: "EDITION_MAX",
+}
+
+var Edition_value = map[string]int32{
+	"EDITION_UNKNOWN":         0,
+	"EDITION_LEGACY":          900,
+	"EDITION_PROTO2":          998,
+	"EDITION_PROTO3":          999,
+	"EDITION_2023":            1000,
+	"EDITION_2024":            1001,
+	"EDITION_1_TEST_ONLY":     1,
+	"EDITION_2_TEST_ONLY":     2,
+	"EDITION_99997_TEST_ONLY": 99997,
+	"EDITION_99998_TEST_ONLY": 99998,
+	"EDITION_99999_TEST_ONLY": 99999,
+	"EDITION_MAX":             2147483647,
+}
+
+func (x Edition) Enum() *Edition {
+	p := new(Edition)
+	*p = x
+	return p
+}
+
+func (x Edition) String() string {
+	return proto.EnumName(Edition_name, int32(x))
+}
+
+func (x *Edition) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Edition_value, data, "Edition")
+	if err != nil {
+		return err
+	}
+	*x = Edition(value)
+	return nil
+}
+
+func (Edition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{0}
+}
+
+// The verification state of the extension range.
+type ExtensionRangeOptions_VerificationState int32
+
+const (
+	// All the extensions of the range must be declared.
+	ExtensionRangeOptions_DECLARATION ExtensionRangeOptions_VerificationState = 0
+	ExtensionRangeOptions_UNVERIFIED  ExtensionRangeOptions_VerificationState = 1
+)
+
+var ExtensionRangeOptions_VerificationState_name = map[int32]string{
+	0: "DECLARATION",
+	1: "UNVERIFIED",
+}
+
+var ExtensionRangeOptions_VerificationState_value = map[string]int32{
+	"DECLARATION": 0,
+	"UNVERIFIED":  1,
+}
+
+func (x ExtensionRangeOptions_VerificationState) Enum() *ExtensionRangeOptions_VerificationState {
+	p := new(ExtensionRangeOptions_VerificationState)
+	*p = x
+	return p
+}
+
+func (x ExtensionRangeOptions_VerificationState) String() string {
+	return proto.EnumName(ExtensionRangeOptions_VerificationState_name, int32(x))
+}
+
+func (x *ExtensionRangeOptions_VerificationState) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(ExtensionRangeOptions_VerificationState_value, data, "ExtensionRangeOptions_VerificationState")
+	if err != nil {
+		return err
+	}
+	*x = ExtensionRangeOptions_VerificationState(value)
+	return nil
+}
+
+func (ExtensionRangeOptions_VerificationState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{3, 0}
+}
+
 type FieldDescriptorProto_Type int32
 
 const (
@@ -57,6 +166,11 @@ const (
 	FieldDescriptorProto_TYPE_FIXED32 FieldDescriptorProto_Type = 7
 	FieldDescriptorProto_TYPE_BOOL    FieldDescriptorProto_Type = 8
 	FieldDescriptorProto_TYPE_STRING  FieldDescriptorProto_Type = 9
+	// Tag-delimited aggregate.
+	// Group type is deprecated and not supported after google.protobuf. However, Proto3
+	// implementations should still be able to parse the group wire format and
+	// treat group fields as unknown fields.  In Editions, the group wire format
+	// can be enabled via the `message_encoding` feature.
 	FieldDescriptorProto_TYPE_GROUP   FieldDescriptorProto_Type = 10
 	FieldDescriptorProto_TYPE_MESSAGE FieldDescriptorProto_Type = 11
 	// New in version 2.
@@ -89,6 +203,7 @@ var FieldDescriptorProto_Type_name = map[int32]string{
 	17: "TYPE_SINT32",
 	18: "TYPE_SINT64",
 }
+
 var FieldDescriptorProto_Type_value = map[string]int32{
 	"TYPE_DOUBLE":   1,
 	"TYPE_FLOAT":    2,
@@ -115,9 +230,11 @@ func (x FieldDescriptorProto_Type) Enum() *FieldDescriptorProto_Type {
 	*p = x
 	return p
 }
+
 func (x FieldDescriptorProto_Type) String() string {
 	return proto.EnumName(FieldDescriptorProto_Type_name, int32(x))
 }
+
 func (x *FieldDescriptorProto_Type) UnmarshalJSON(data []byte) error {
 	value, err := proto.UnmarshalJSONEnum(FieldDescriptorProto_Type_value, data, "FieldDescriptorProto_Type")
 	if err != nil {
@@ -127,24 +244,32 @@ func (x *FieldDescriptorProto_Type) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (FieldDescriptorProto_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{4, 0}
+}
+
 type FieldDescriptorProto_Label int32
 
 const (
 	// 0 is reserved for errors
 	FieldDescriptorProto_LABEL_OPTIONAL FieldDescriptorProto_Label = 1
-	FieldDescriptorProto_LABEL_REQUIRED FieldDescriptorProto_Label = 2
 	FieldDescriptorProto_LABEL_REPEATED FieldDescriptorProto_Label = 3
+	// The required label is only allowed in google.protobuf.  In proto3 and Editions
+	// it's explicitly prohibited.  In Editions, the `field_presence` feature
+	// can be used to get this behavior.
+	FieldDescriptorProto_LABEL_REQUIRED FieldDescriptorProto_Label = 2
 )
 
 var FieldDescriptorProto_Label_name = map[int32]string{
 	1: "LABEL_OPTIONAL",
-	2: "LABEL_REQUIRED",
 	3: "LABEL_REPEATED",
+	2: "LABEL_REQUIRED",
 }
+
 var FieldDescriptorProto_Label_value = map[string]int32{
 	"LABEL_OPTIONAL": 1,
-	"LABEL_REQUIRED": 2,
 	"LABEL_REPEATED": 3,
+	"LABEL_REQUIRED": 2,
 }
 
 func (x FieldDescriptorProto_Label) Enum() *FieldDescriptorProto_Label {
@@ -152,9 +277,11 @@ func (x FieldDescriptorProto_Label) Enum() *FieldDescriptorProto_Label {
 	*p = x
 	return p
 }
+
 func (x FieldDescriptorProto_Label) String() string {
 	return proto.EnumName(FieldDescriptorProto_Label_name, int32(x))
 }
+
 func (x *FieldDescriptorProto_Label) UnmarshalJSON(data []byte) error {
 	value, err := proto.UnmarshalJSONEnum(FieldDescriptorProto_Label_value, data, "FieldDescriptorProto_Label")
 	if err != nil {
@@ -164,6 +291,10 @@ func (x *FieldDescriptorProto_Label) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (FieldDescriptorProto_Label) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{4, 1}
+}
+
 // Generated classes can be optimized for speed or code size.
 type FileOptions_OptimizeMode int32
 
@@ -179,6 +310,7 @@ var FileOptions_OptimizeMode_name = map[int32]string{
 	2: "CODE_SIZE",
 	3: "LITE_RUNTIME",
 }
+
 var FileOptions_OptimizeMode_value = map[string]int32{
 	"SPEED":        1,
 	"CODE_SIZE":    2,
@@ -190,9 +322,11 @@ func (x FileOptions_OptimizeMode) Enum() *FileOptions_OptimizeMode {
 	*p = x
 	return p
 }
+
 func (x FileOptions_OptimizeMode) String() string {
 	return proto.EnumName(FileOptions_OptimizeMode_name, int32(x))
 }
+
 func (x *FileOptions_OptimizeMode) UnmarshalJSON(data []byte) error {
 	value, err := proto.UnmarshalJSONEnum(FileOptions_OptimizeMode_value, data, "FileOptions_OptimizeMode")
 	if err != nil {
@@ -202,11 +336,21 @@ func (x *FileOptions_OptimizeMode) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (FileOptions_OptimizeMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{10, 0}
+}
+
 type FieldOptions_CType int32
 
 const (
 	// Default mode.
-	FieldOptions_STRING       FieldOptions_CType = 0
+	FieldOptions_STRING FieldOptions_CType = 0
+	// The option [ctype=CORD] may be applied to a non-repeated field of type
+	// "bytes". It indicates that in C++, the data should be stored in a Cord
+	// instead of a string.  For very large strings, this may reduce memory
+	// fragmentation. It may also allow better performance when parsing from a
+	// Cord, or when parsing with aliasing enabled, as the parsed Cord may then
+	// alias the original buffer.
 	FieldOptions_CORD         FieldOptions_CType = 1
 	FieldOptions_STRING_PIECE FieldOptions_CType = 2
 )
@@ -216,6 +360,7 @@ var FieldOptions_CType_name = map[int32]string{
 	1: "CORD",
 	2: "STRING_PIECE",
 }
+
 var FieldOptions_CType_value = map[string]int32{
 	"STRING":       0,
 	"CORD":         1,
@@ -227,9 +372,11 @@ func (x FieldOptions_CType) Enum() *FieldOptions_CType {
 	*p = x
 	return p
 }
+
 func (x FieldOptions_CType) String() string {
 	return proto.EnumName(FieldOptions_CType_name, int32(x))
 }
+
 func (x *FieldOptions_CType) UnmarshalJSON(data []byte) error {
 	value, err := proto.UnmarshalJSONEnum(FieldOptions_CType_value, data, "FieldOptions_CType")
 	if err != nil {
@@ -239,16 +386,557 @@ func (x *FieldOptions_CType) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (FieldOptions_CType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{12, 0}
+}
+
+type FieldOptions_JSType int32
+
+const (
+	// Use the default type.
+	FieldOptions_JS_NORMAL FieldOptions_JSType = 0
+	// Use JavaScript strings.
+	FieldOptions_JS_STRING FieldOptions_JSType = 1
+	// Use JavaScript numbers.
+	FieldOptions_JS_NUMBER FieldOptions_JSType = 2
+)
+
+var FieldOptions_JSType_name = map[int32]string{
+	0: "JS_NORMAL",
+	1: "JS_STRING",
+	2: "JS_NUMBER",
+}
+
+var FieldOptions_JSType_value = map[string]int32{
+	"JS_NORMAL": 0,
+	"JS_STRING": 1,
+	"JS_NUMBER": 2,
+}
+
+func (x FieldOptions_JSType) Enum() *FieldOptions_JSType {
+	p := new(FieldOptions_JSType)
+	*p = x
+	return p
+}
+
+func (x FieldOptions_JSType) String() string {
+	return proto.EnumName(FieldOptions_JSType_name, int32(x))
+}
+
+func (x *FieldOptions_JSType) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(FieldOptions_JSType_value, data, "FieldOptions_JSType")
+	if err != nil {
+		return err
+	}
+	*x = FieldOptions_JSType(value)
+	return nil
+}
+
+func (FieldOptions_JSType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{12, 1}
+}
+
+// If set to RETENTION_SOURCE, the option will be omitted from the binary.
+// Note: as of January 2023, support for this is in progress and does not yet
+// have an effect (b/264593489).
+type FieldOptions_OptionRetention int32
+
+const (
+	FieldOptions_RETENTION_UNKNOWN FieldOptions_OptionRetention = 0
+	FieldOptions_RETENTION_RUNTIME FieldOptions_OptionRetention = 1
+	FieldOptions_RETENTION_SOURCE  FieldOptions_OptionRetention = 2
+)
+
+var FieldOptions_OptionRetention_name = map[int32]string{
+	0: "RETENTION_UNKNOWN",
+	1: "RETENTION_RUNTIME",
+	2: "RETENTION_SOURCE",
+}
+
+var FieldOptions_OptionRetention_value = map[string]int32{
+	"RETENTION_UNKNOWN": 0,
+	"RETENTION_RUNTIME": 1,
+	"RETENTION_SOURCE":  2,
+}
+
+func (x FieldOptions_OptionRetention) Enum() *FieldOptions_OptionRetention {
+	p := new(FieldOptions_OptionRetention)
+	*p = x
+	return p
+}
+
+func (x FieldOptions_OptionRetention) String() string {
+	return proto.EnumName(FieldOptions_OptionRetention_name, int32(x))
+}
+
+func (x *FieldOptions_OptionRetention) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(FieldOptions_OptionRetention_value, data, "FieldOptions_OptionRetention")
+	if err != nil {
+		return err
+	}
+	*x = FieldOptions_OptionRetention(value)
+	return nil
+}
+
+func (FieldOptions_OptionRetention) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{12, 2}
+}
+
+// This indicates the types of entities that the field may apply to when used
+// as an option. If it is unset, then the field may be freely used as an
+// option on any kind of entity. Note: as of January 2023, support for this is
+// in progress and does not yet have an effect (b/264593489).
+type FieldOptions_OptionTargetType int32
+
+const (
+	FieldOptions_TARGET_TYPE_UNKNOWN         FieldOptions_OptionTargetType = 0
+	FieldOptions_TARGET_TYPE_FILE            FieldOptions_OptionTargetType = 1
+	FieldOptions_TARGET_TYPE_EXTENSION_RANGE FieldOptions_OptionTargetType = 2
+	FieldOptions_TARGET_TYPE_MESSAGE         FieldOptions_OptionTargetType = 3
+	FieldOptions_TARGET_TYPE_FIELD           FieldOptions_OptionTargetType = 4
+	FieldOptions_TARGET_TYPE_ONEOF           FieldOptions_OptionTargetType = 5
+	FieldOptions_TARGET_TYPE_ENUM            FieldOptions_OptionTargetType = 6
+	FieldOptions_TARGET_TYPE_ENUM_ENTRY      FieldOptions_OptionTargetType = 7
+	FieldOptions_TARGET_TYPE_SERVICE         FieldOptions_OptionTargetType = 8
+	FieldOptions_TARGET_TYPE_METHOD          FieldOptions_OptionTargetType = 9
+)
+
+var FieldOptions_OptionTargetType_name = map[int32]string{
+	0: "TARGET_TYPE_UNKNOWN",
+	1: "TARGET_TYPE_FILE",
+	2: "TARGET_TYPE_EXTENSION_RANGE",
+	3: "TARGET_TYPE_MESSAGE",
+	4: "TARGET_TYPE_FIELD",
+	5: "TARGET_TYPE_ONEOF",
+	6: "TARGET_TYPE_ENUM",
+	7: "TARGET_TYPE_ENUM_ENTRY",
+	8: "TARGET_TYPE_SERVICE",
+	9: "TARGET_TYPE_METHOD",
+}
+
+var FieldOptions_OptionTargetType_value = map[string]int32{
+	"TARGET_TYPE_UNKNOWN":         0,
+	"TARGET_TYPE_FILE":            1,
+	"TARGET_TYPE_EXTENSION_RANGE": 2,
+	"TARGET_TYPE_MESSAGE":         3,
+	"TARGET_TYPE_FIELD":           4,
+	"TARGET_TYPE_ONEOF":           5,
+	"TARGET_TYPE_ENUM":            6,
+	"TARGET_TYPE_ENUM_ENTRY":      7,
+	"TARGET_TYPE_SERVICE":         8,
+	"TARGET_TYPE_METHOD":          9,
+}
+
+func (x FieldOptions_OptionTargetType) Enum() *FieldOptions_OptionTargetType {
+	p := new(FieldOptions_OptionTargetType)
+	*p = x
+	return p
+}
+
+func (x FieldOptions_OptionTargetType) String() string {
+	return proto.EnumName(FieldOptions_OptionTargetType_name, int32(x))
+}
+
+func (x *FieldOptions_OptionTargetType) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(FieldOptions_OptionTargetType_value, data, "FieldOptions_OptionTargetType")
+	if err != nil {
+		return err
+	}
+	*x = FieldOptions_OptionTargetType(value)
+	return nil
+}
+
+func (FieldOptions_OptionTargetType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{12, 3}
+}
+
+// Is this method side-effect-free (or safe in HTTP parlance), or idempotent,
+// or neither? HTTP based RPC implementation may choose GET verb for safe
+// methods, and PUT verb for idempotent methods instead of the default POST.
+type MethodOptions_IdempotencyLevel int32
+
+const (
+	MethodOptions_IDEMPOTENCY_UNKNOWN MethodOptions_IdempotencyLevel = 0
+	MethodOptions_NO_SIDE_EFFECTS     MethodOptions_IdempotencyLevel = 1
+	MethodOptions_IDEMPOTENT          MethodOptions_IdempotencyLevel = 2
+)
+
+var MethodOptions_IdempotencyLevel_name = map[int32]string{
+	0: "IDEMPOTENCY_UNKNOWN",
+	1: "NO_SIDE_EFFECTS",
+	2: "IDEMPOTENT",
+}
+
+var MethodOptions_IdempotencyLevel_value = map[string]int32{
+	"IDEMPOTENCY_UNKNOWN": 0,
+	"NO_SIDE_EFFECTS":     1,
+	"IDEMPOTENT":          2,
+}
+
+func (x MethodOptions_IdempotencyLevel) Enum() *MethodOptions_IdempotencyLevel {
+	p := new(MethodOptions_IdempotencyLevel)
+	*p = x
+	return p
+}
+
+func (x MethodOptions_IdempotencyLevel) String() string {
+	return proto.EnumName(MethodOptions_IdempotencyLevel_name, int32(x))
+}
+
+func (x *MethodOptions_IdempotencyLevel) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(MethodOptions_IdempotencyLevel_value, data, "MethodOptions_IdempotencyLevel")
+	if err != nil {
+		return err
+	}
+	*x = MethodOptions_IdempotencyLevel(value)
+	return nil
+}
+
+func (MethodOptions_IdempotencyLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{17, 0}
+}
+
+type FeatureSet_FieldPresence int32
+
+const (
+	FeatureSet_FIELD_PRESENCE_UNKNOWN FeatureSet_FieldPresence = 0
+	FeatureSet_EXPLICIT               FeatureSet_FieldPresence = 1
+	FeatureSet_IMPLICIT               FeatureSet_FieldPresence = 2
+	FeatureSet_LEGACY_REQUIRED        FeatureSet_FieldPresence = 3
+)
+
+var FeatureSet_FieldPresence_name = map[int32]string{
+	0: "FIELD_PRESENCE_UNKNOWN",
+	1: "EXPLICIT",
+	2: "IMPLICIT",
+	3: "LEGACY_REQUIRED",
+}
+
+var FeatureSet_FieldPresence_value = map[string]int32{
+	"FIELD_PRESENCE_UNKNOWN": 0,
+	"EXPLICIT":               1,
+	"IMPLICIT":               2,
+	"LEGACY_REQUIRED":        3,
+}
+
+func (x FeatureSet_FieldPresence) Enum() *FeatureSet_FieldPresence {
+	p := new(FeatureSet_FieldPresence)
+	*p = x
+	return p
+}
+
+func (x FeatureSet_FieldPresence) String() string {
+	return proto.EnumName(FeatureSet_FieldPresence_name, int32(x))
+}
+
+func (x *FeatureSet_FieldPresence) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(FeatureSet_FieldPresence_value, data, "FeatureSet_FieldPresence")
+	if err != nil {
+		return err
+	}
+	*x = FeatureSet_FieldPresence(value)
+	return nil
+}
+
+func (FeatureSet_FieldPresence) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{19, 0}
+}
+
+type FeatureSet_EnumType int32
+
+const (
+	FeatureSet_ENUM_TYPE_UNKNOWN FeatureSet_EnumType = 0
+	FeatureSet_OPEN              FeatureSet_EnumType = 1
+	FeatureSet_CLOSED            FeatureSet_EnumType = 2
+)
+
+var FeatureSet_EnumType_name = map[int32]string{
+	0: "ENUM_TYPE_UNKNOWN",
+	1: "OPEN",
+	2: "CLOSED",
+}
+
+var FeatureSet_EnumType_value = map[string]int32{
+	"ENUM_TYPE_UNKNOWN": 0,
+	"OPEN":              1,
+	"CLOSED":            2,
+}
+
+func (x FeatureSet_EnumType) Enum() *FeatureSet_EnumType {
+	p := new(FeatureSet_EnumType)
+	*p = x
+	return p
+}
+
+func (x FeatureSet_EnumType) String() string {
+	return proto.EnumName(FeatureSet_EnumType_name, int32(x))
+}
+
+func (x *FeatureSet_EnumType) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(FeatureSet_EnumType_value, data, "FeatureSet_EnumType")
+	if err != nil {
+		return err
+	}
+	*x = FeatureSet_EnumType(value)
+	return nil
+}
+
+func (FeatureSet_EnumType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{19, 1}
+}
+
+type FeatureSet_RepeatedFieldEncoding int32
+
+const (
+	FeatureSet_REPEATED_FIELD_ENCODING_UNKNOWN FeatureSet_RepeatedFieldEncoding = 0
+	FeatureSet_PACKED                          FeatureSet_RepeatedFieldEncoding = 1
+	FeatureSet_EXPANDED                        FeatureSet_RepeatedFieldEncoding = 2
+)
+
+var FeatureSet_RepeatedFieldEncoding_name = map[int32]string{
+	0: "REPEATED_FIELD_ENCODING_UNKNOWN",
+	1: "PACKED",
+	2: "EXPANDED",
+}
+
+var FeatureSet_RepeatedFieldEncoding_value = map[string]int32{
+	"REPEATED_FIELD_ENCODING_UNKNOWN": 0,
+	"PACKED":                          1,
+	"EXPANDED":                        2,
+}
+
+func (x FeatureSet_RepeatedFieldEncoding) Enum() *FeatureSet_RepeatedFieldEncoding {
+	p := new(FeatureSet_RepeatedFieldEncoding)
+	*p = x
+	return p
+}
+
+func (x FeatureSet_RepeatedFieldEncoding) String() string {
+	return proto.EnumName(FeatureSet_RepeatedFieldEncoding_name, int32(x))
+}
+
+func (x *FeatureSet_RepeatedFieldEncoding) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(FeatureSet_RepeatedFieldEncoding_value, data, "FeatureSet_RepeatedFieldEncoding")
+	if err != nil {
+		return err
+	}
+	*x = FeatureSet_RepeatedFieldEncoding(value)
+	return nil
+}
+
+func (FeatureSet_RepeatedFieldEncoding) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{19, 2}
+}
+
+type FeatureSet_Utf8Validation int32
+
+const (
+	FeatureSet_UTF8_VALIDATION_UNKNOWN FeatureSet_Utf8Validation = 0
+	FeatureSet_VERIFY                  FeatureSet_Utf8Validation = 2
+	FeatureSet_NONE                    FeatureSet_Utf8Validation = 3
+)
+
+var FeatureSet_Utf8Validation_name = map[int32]string{
+	0: "UTF8_VALIDATION_UNKNOWN",
+	2: "VERIFY",
+	3: "NONE",
+}
+
+var FeatureSet_Utf8Validation_value = map[string]int32{
+	"UTF8_VALIDATION_UNKNOWN": 0,
+	"VERIFY":                  2,
+	"NONE":                    3,
+}
+
+func (x FeatureSet_Utf8Validation) Enum() *FeatureSet_Utf8Validation {
+	p := new(FeatureSet_Utf8Validation)
+	*p = x
+	return p
+}
+
+func (x FeatureSet_Utf8Validation) String() string {
+	return proto.EnumName(FeatureSet_Utf8Validation_name, int32(x))
+}
+
+func (x *FeatureSet_Utf8Validation) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(FeatureSet_Utf8Validation_value, data, "FeatureSet_Utf8Validation")
+	if err != nil {
+		return err
+	}
+	*x = FeatureSet_Utf8Validation(value)
+	return nil
+}
+
+func (FeatureSet_Utf8Validation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{19, 3}
+}
+
+type FeatureSet_MessageEncoding int32
+
+const (
+	FeatureSet_MESSAGE_ENCODING_UNKNOWN FeatureSet_MessageEncoding = 0
+	FeatureSet_LENGTH_PREFIXED          FeatureSet_MessageEncoding = 1
+	FeatureSet_DELIMITED                FeatureSet_MessageEncoding = 2
+)
+
+var FeatureSet_MessageEncoding_name = map[int32]string{
+	0: "MESSAGE_ENCODING_UNKNOWN",
+	1: "LENGTH_PREFIXED",
+	2: "DELIMITED",
+}
+
+var FeatureSet_MessageEncoding_value = map[string]int32{
+	"MESSAGE_ENCODING_UNKNOWN": 0,
+	"LENGTH_PREFIXED":          1,
+	"DELIMITED":                2,
+}
+
+func (x FeatureSet_MessageEncoding) Enum() *FeatureSet_MessageEncoding {
+	p := new(FeatureSet_MessageEncoding)
+	*p = x
+	return p
+}
+
+func (x FeatureSet_MessageEncoding) String() string {
+	return proto.EnumName(FeatureSet_MessageEncoding_name, int32(x))
+}
+
+func (x *FeatureSet_MessageEncoding) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(FeatureSet_MessageEncoding_value, data, "FeatureSet_MessageEncoding")
+	if err != nil {
+		return err
+	}
+	*x = FeatureSet_MessageEncoding(value)
+	return nil
+}
+
+func (FeatureSet_MessageEncoding) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{19, 4}
+}
+
+type FeatureSet_JsonFormat int32
+
+const (
+	FeatureSet_JSON_FORMAT_UNKNOWN FeatureSet_JsonFormat = 0
+	FeatureSet_ALLOW               FeatureSet_JsonFormat = 1
+	FeatureSet_LEGACY_BEST_EFFORT  FeatureSet_JsonFormat = 2
+)
+
+var FeatureSet_JsonFormat_name = map[int32]string{
+	0: "JSON_FORMAT_UNKNOWN",
+	1: "ALLOW",
+	2: "LEGACY_BEST_EFFORT",
+}
+
+var FeatureSet_JsonFormat_value = map[string]int32{
+	"JSON_FORMAT_UNKNOWN": 0,
+	"ALLOW":               1,
+	"LEGACY_BEST_EFFORT":  2,
+}
+
+func (x FeatureSet_JsonFormat) Enum() *FeatureSet_JsonFormat {
+	p := new(FeatureSet_JsonFormat)
+	*p = x
+	return p
+}
+
+func (x FeatureSet_JsonFormat) String() string {
+	return proto.EnumName(FeatureSet_JsonFormat_name, int32(x))
+}
+
+func (x *FeatureSet_JsonFormat) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(FeatureSet_JsonFormat_value, data, "FeatureSet_JsonFormat")
+	if err != nil {
+		return err
+	}
+	*x = FeatureSet_JsonFormat(value)
+	return nil
+}
+
+func (FeatureSet_JsonFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{19, 5}
+}
+
+// Represents the identified object's effect on the element in the original
+// .proto file.
+type GeneratedCodeInfo_Annotation_Semantic int32
+
+const (
+	// There is no effect or the effect is indescribable.
+	GeneratedCodeInfo_Annotation_NONE GeneratedCodeInfo_Annotation_Semantic = 0
+	// The element is set or otherwise mutated.
+	GeneratedCodeInfo_Annotation_SET GeneratedCodeInfo_Annotation_Semantic = 1
+	// An alias to the element is returned.
+	GeneratedCodeInfo_Annotation_ALIAS GeneratedCodeInfo_Annotation_Semantic = 2
+)
+
+var GeneratedCodeInfo_Annotation_Semantic_name = map[int32]string{
+	0: "NONE",
+	1: "SET",
+	2: "ALIAS",
+}
+
+var GeneratedCodeInfo_Annotation_Semantic_value = map[string]int32{
+	"NONE":  0,
+	"SET":   1,
+	"ALIAS": 2,
+}
+
+func (x GeneratedCodeInfo_Annotation_Semantic) Enum() *GeneratedCodeInfo_Annotation_Semantic {
+	p := new(GeneratedCodeInfo_Annotation_Semantic)
+	*p = x
+	return p
+}
+
+func (x GeneratedCodeInfo_Annotation_Semantic) String() string {
+	return proto.EnumName(GeneratedCodeInfo_Annotation_Semantic_name, int32(x))
+}
+
+func (x *GeneratedCodeInfo_Annotation_Semantic) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(GeneratedCodeInfo_Annotation_Semantic_value, data, "GeneratedCodeInfo_Annotation_Semantic")
+	if err != nil {
+		return err
+	}
+	*x = GeneratedCodeInfo_Annotation_Semantic(value)
+	return nil
+}
+
+func (GeneratedCodeInfo_Annotation_Semantic) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{22, 0, 0}
+}
+
 // The protocol compiler can output a FileDescriptorSet containing the .proto
 // files it parses.
 type FileDescriptorSet struct {
-	File             []*FileDescriptorProto `protobuf:"bytes,1,rep,name=file" json:"file,omitempty"`
-	XXX_unrecognized []byte                 `json:"-"`
+	File                 []*FileDescriptorProto `protobuf:"bytes,1,rep,name=file" json:"file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *FileDescriptorSet) Reset()         { *m = FileDescriptorSet{} }
 func (m *FileDescriptorSet) String() string { return proto.CompactTextString(m) }
 func (*FileDescriptorSet) ProtoMessage()    {}
+func (*FileDescriptorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{0}
+}
+func (m *FileDescriptorSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileDescriptorSet.Unmarshal(m, b)
+}
+func (m *FileDescriptorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileDescriptorSet.Marshal(b, m, deterministic)
+}
+func (m *FileDescriptorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileDescriptorSet.Merge(m, src)
+}
+func (m *FileDescriptorSet) XXX_Size() int {
+	return xxx_messageInfo_FileDescriptorSet.Size(m)
+}
+func (m *FileDescriptorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileDescriptorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileDescriptorSet proto.InternalMessageInfo
 
 func (m *FileDescriptorSet) GetFile() []*FileDescriptorProto {
 	if m != nil {
@@ -264,27 +952,56 @@ type FileDescriptorProto struct {
 	// Names of files imported by this file.
 	Dependency []string `protobuf:"bytes,3,rep,name=dependency" json:"dependency,omitempty"`
 	// Indexes of the public imported files in the dependency list above.
-	PublicDependency []int32 `protobuf:"varint,10,rep,name=public_dependency" json:"public_dependency,omitempty"`
+	PublicDependency []int32 `protobuf:"varint,10,rep,name=public_dependency,json=publicDependency" json:"public_dependency,omitempty"`
 	// Indexes of the weak imported files in the dependency list.
 	// For Google-internal migration only. Do not use.
-	WeakDependency []int32 `protobuf:"varint,11,rep,name=weak_dependency" json:"weak_dependency,omitempty"`
+	WeakDependency []int32 `protobuf:"varint,11,rep,name=weak_dependency,json=weakDependency" json:"weak_dependency,omitempty"`
 	// All top-level definitions in this file.
-	MessageType []*DescriptorProto        `protobuf:"bytes,4,rep,name=message_type" json:"message_type,omitempty"`
-	EnumType    []*EnumDescriptorProto    `protobuf:"bytes,5,rep,name=enum_type" json:"enum_type,omitempty"`
+	MessageType []*DescriptorProto        `protobuf:"bytes,4,rep,name=message_type,json=messageType" json:"message_type,omitempty"`
+	EnumType    []*EnumDescriptorProto    `protobuf:"bytes,5,rep,name=enum_type,json=enumType" json:"enum_type,omitempty"`
 	Service     []*ServiceDescriptorProto `protobuf:"bytes,6,rep,name=service" json:"service,omitempty"`
 	Extension   []*FieldDescriptorProto   `protobuf:"bytes,7,rep,name=extension" json:"extension,omitempty"`
 	Options     *FileOptions              `protobuf:"bytes,8,opt,name=options" json:"options,omitempty"`
 	// This field contains optional information about the original source code.
-	// You may safely remove this entire field whithout harming runtime
+	// You may safely remove this entire field without harming runtime
 	// functionality of the descriptors -- the information is needed only by
 	// development tools.
-	SourceCodeInfo   *SourceCodeInfo `protobuf:"bytes,9,opt,name=source_code_info" json:"source_code_info,omitempty"`
-	XXX_unrecognized []byte          `json:"-"`
+	SourceCodeInfo *SourceCodeInfo `protobuf:"bytes,9,opt,name=source_code_info,json=sourceCodeInfo" json:"source_code_info,omitempty"`
+	// The syntax of the proto file.
+	// The supported values are "proto2", "proto3", and "editions".
+	//
+	// If `edition` is present, this value must be "editions".
+	Syntax *string `protobuf:"bytes,12,opt,name=syntax" json:"syntax,omitempty"`
+	// The edition of the proto file.
+	Edition              *Edition `protobuf:"varint,14,opt,name=edition,enum=google.protobuf.Edition" json:"edition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *FileDescriptorProto) Reset()         { *m = FileDescriptorProto{} }
 func (m *FileDescriptorProto) String() string { return proto.CompactTextString(m) }
 func (*FileDescriptorProto) ProtoMessage()    {}
+func (*FileDescriptorProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{1}
+}
+func (m *FileDescriptorProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileDescriptorProto.Unmarshal(m, b)
+}
+func (m *FileDescriptorProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileDescriptorProto.Marshal(b, m, deterministic)
+}
+func (m *FileDescriptorProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileDescriptorProto.Merge(m, src)
+}
+func (m *FileDescriptorProto) XXX_Size() int {
+	return xxx_messageInfo_FileDescriptorProto.Size(m)
+}
+func (m *FileDescriptorProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileDescriptorProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileDescriptorProto proto.InternalMessageInfo
 
 func (m *FileDescriptorProto) GetName() string {
 	if m != nil && m.Name != nil {
@@ -363,22 +1080,62 @@ func (m *FileDescriptorProto) GetSourceCodeInfo() *SourceCodeInfo {
 	return nil
 }
 
+func (m *FileDescriptorProto) GetSyntax() string {
+	if m != nil && m.Syntax != nil {
+		return *m.Syntax
+	}
+	return ""
+}
+
+func (m *FileDescriptorProto) GetEdition() Edition {
+	if m != nil && m.Edition != nil {
+		return *m.Edition
+	}
+	return Edition_EDITION_UNKNOWN
+}
+
 // Describes a message type.
 type DescriptorProto struct {
-	Name             *string                           `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Field            []*FieldDescriptorProto           `protobuf:"bytes,2,rep,name=field" json:"field,omitempty"`
-	Extension        []*FieldDescriptorProto           `protobuf:"bytes,6,rep,name=extension" json:"extension,omitempty"`
-	NestedType       []*DescriptorProto                `protobuf:"bytes,3,rep,name=nested_type" json:"nested_type,omitempty"`
-	EnumType         []*EnumDescriptorProto            `protobuf:"bytes,4,rep,name=enum_type" json:"enum_type,omitempty"`
-	ExtensionRange   []*DescriptorProto_ExtensionRange `protobuf:"bytes,5,rep,name=extension_range" json:"extension_range,omitempty"`
-	OneofDecl        []*OneofDescriptorProto           `protobuf:"bytes,8,rep,name=oneof_decl" json:"oneof_decl,omitempty"`
-	Options          *MessageOptions                   `protobuf:"bytes,7,opt,name=options" json:"options,omitempty"`
-	XXX_unrecognized []byte                            `json:"-"`
+	Name           *string                           `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Field          []*FieldDescriptorProto           `protobuf:"bytes,2,rep,name=field" json:"field,omitempty"`
+	Extension      []*FieldDescriptorProto           `protobuf:"bytes,6,rep,name=extension" json:"extension,omitempty"`
+	NestedType     []*DescriptorProto                `protobuf:"bytes,3,rep,name=nested_type,json=nestedType" json:"nested_type,omitempty"`
+	EnumType       []*EnumDescriptorProto            `protobuf:"bytes,4,rep,name=enum_type,json=enumType" json:"enum_type,omitempty"`
+	ExtensionRange []*DescriptorProto_ExtensionRange `protobuf:"bytes,5,rep,name=extension_range,json=extensionRange" json:"extension_range,omitempty"`
+	OneofDecl      []*OneofDescriptorProto           `protobuf:"bytes,8,rep,name=oneof_decl,json=oneofDecl" json:"oneof_decl,omitempty"`
+	Options        *MessageOptions                   `protobuf:"bytes,7,opt,name=options" json:"options,omitempty"`
+	ReservedRange  []*DescriptorProto_ReservedRange  `protobuf:"bytes,9,rep,name=reserved_range,json=reservedRange" json:"reserved_range,omitempty"`
+	// Reserved field names, which may not be used by fields in the same message.
+	// A given name may only be reserved once.
+	ReservedName         []string `protobuf:"bytes,10,rep,name=reserved_name,json=reservedName" json:"reserved_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DescriptorProto) Reset()         { *m = DescriptorProto{} }
 func (m *DescriptorProto) String() string { return proto.CompactTextString(m) }
 func (*DescriptorProto) ProtoMessage()    {}
+func (*DescriptorProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{2}
+}
+func (m *DescriptorProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescriptorProto.Unmarshal(m, b)
+}
+func (m *DescriptorProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescriptorProto.Marshal(b, m, deterministic)
+}
+func (m *DescriptorProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescriptorProto.Merge(m, src)
+}
+func (m *DescriptorProto) XXX_Size() int {
+	return xxx_messageInfo_DescriptorProto.Size(m)
+}
+func (m *DescriptorProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescriptorProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescriptorProto proto.InternalMessageInfo
 
 func (m *DescriptorProto) GetName() string {
 	if m != nil && m.Name != nil {
@@ -436,15 +1193,52 @@ func (m *DescriptorProto) GetOptions() *MessageOptions {
 	return nil
 }
 
+func (m *DescriptorProto) GetReservedRange() []*DescriptorProto_ReservedRange {
+	if m != nil {
+		return m.ReservedRange
+	}
+	return nil
+}
+
+func (m *DescriptorProto) GetReservedName() []string {
+	if m != nil {
+		return m.ReservedName
+	}
+	return nil
+}
+
 type DescriptorProto_ExtensionRange struct {
-	Start            *int32 `protobuf:"varint,1,opt,name=start" json:"start,omitempty"`
-	End              *int32 `protobuf:"varint,2,opt,name=end" json:"end,omitempty"`
-	XXX_unrecognized []byte `json:"-"`
+	Start                *int32                 `protobuf:"varint,1,opt,name=start" json:"start,omitempty"`
+	End                  *int32                 `protobuf:"varint,2,opt,name=end" json:"end,omitempty"`
+	Options              *ExtensionRangeOptions `protobuf:"bytes,3,opt,name=options" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *DescriptorProto_ExtensionRange) Reset()         { *m = DescriptorProto_ExtensionRange{} }
 func (m *DescriptorProto_ExtensionRange) String() string { return proto.CompactTextString(m) }
 func (*DescriptorProto_ExtensionRange) ProtoMessage()    {}
+func (*DescriptorProto_ExtensionRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{2, 0}
+}
+func (m *DescriptorProto_ExtensionRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescriptorProto_ExtensionRange.Unmarshal(m, b)
+}
+func (m *DescriptorProto_ExtensionRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescriptorProto_ExtensionRange.Marshal(b, m, deterministic)
+}
+func (m *DescriptorProto_ExtensionRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescriptorProto_ExtensionRange.Merge(m, src)
+}
+func (m *DescriptorProto_ExtensionRange) XXX_Size() int {
+	return xxx_messageInfo_DescriptorProto_ExtensionRange.Size(m)
+}
+func (m *DescriptorProto_ExtensionRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescriptorProto_ExtensionRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescriptorProto_ExtensionRange proto.InternalMessageInfo
 
 func (m *DescriptorProto_ExtensionRange) GetStart() int32 {
 	if m != nil && m.Start != nil {
@@ -460,6 +1254,225 @@ func (m *DescriptorProto_ExtensionRange) GetEnd() int32 {
 	return 0
 }
 
+func (m *DescriptorProto_ExtensionRange) GetOptions() *ExtensionRangeOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// Range of reserved tag numbers. Reserved tag numbers may not be used by
+// fields or extension ranges in the same message. Reserved ranges may
+// not overlap.
+type DescriptorProto_ReservedRange struct {
+	Start                *int32   `protobuf:"varint,1,opt,name=start" json:"start,omitempty"`
+	End                  *int32   `protobuf:"varint,2,opt,name=end" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescriptorProto_ReservedRange) Reset()         { *m = DescriptorProto_ReservedRange{} }
+func (m *DescriptorProto_ReservedRange) String() string { return proto.CompactTextString(m) }
+func (*DescriptorProto_ReservedRange) ProtoMessage()    {}
+func (*DescriptorProto_ReservedRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{2, 1}
+}
+func (m *DescriptorProto_ReservedRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescriptorProto_ReservedRange.Unmarshal(m, b)
+}
+func (m *DescriptorProto_ReservedRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescriptorProto_ReservedRange.Marshal(b, m, deterministic)
+}
+func (m *DescriptorProto_ReservedRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescriptorProto_ReservedRange.Merge(m, src)
+}
+func (m *DescriptorProto_ReservedRange) XXX_Size() int {
+	return xxx_messageInfo_DescriptorProto_ReservedRange.Size(m)
+}
+func (m *DescriptorProto_ReservedRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescriptorProto_ReservedRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescriptorProto_ReservedRange proto.InternalMessageInfo
+
+func (m *DescriptorProto_ReservedRange) GetStart() int32 {
+	if m != nil && m.Start != nil {
+		return *m.Start
+	}
+	return 0
+}
+
+func (m *DescriptorProto_ReservedRange) GetEnd() int32 {
+	if m != nil && m.End != nil {
+		return *m.End
+	}
+	return 0
+}
+
+type ExtensionRangeOptions struct {
+	// The parser stores options it doesn't recognize here. See above.
+	UninterpretedOption []*UninterpretedOption `protobuf:"bytes,999,rep,name=uninterpreted_option,json=uninterpretedOption" json:"uninterpreted_option,omitempty"`
+	// For external users: DO NOT USE. We are in the process of open sourcing
+	// extension declaration and executing internal cleanups before it can be
+	// used externally.
+	Declaration []*ExtensionRangeOptions_Declaration `protobuf:"bytes,2,rep,name=declaration" json:"declaration,omitempty"`
+	// Any features defined in the specific edition.
+	Features *FeatureSet `protobuf:"bytes,50,opt,name=features" json:"features,omitempty"`
+	// The verification state of the range.
+	// TODO: flip the default to DECLARATION once all empty ranges
+	// are marked as UNVERIFIED.
+	Verification                 *ExtensionRangeOptions_VerificationState `protobuf:"varint,3,opt,name=verification,enum=google.protobuf.ExtensionRangeOptions_VerificationState,def=1" json:"verification,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                                 `json:"-"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
+}
+
+func (m *ExtensionRangeOptions) Reset()         { *m = ExtensionRangeOptions{} }
+func (m *ExtensionRangeOptions) String() string { return proto.CompactTextString(m) }
+func (*ExtensionRangeOptions) ProtoMessage()    {}
+func (*ExtensionRangeOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{3}
+}
+
+var extRange_ExtensionRangeOptions = []proto.ExtensionRange{
+	{Start: 1000, End: 536870911},
+}
+
+func (*ExtensionRangeOptions) ExtensionRangeArray() []proto.ExtensionRange {
+	return extRange_ExtensionRangeOptions
+}
+
+func (m *ExtensionRangeOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtensionRangeOptions.Unmarshal(m, b)
+}
+func (m *ExtensionRangeOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtensionRangeOptions.Marshal(b, m, deterministic)
+}
+func (m *ExtensionRangeOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionRangeOptions.Merge(m, src)
+}
+func (m *ExtensionRangeOptions) XXX_Size() int {
+	return xxx_messageInfo_ExtensionRangeOptions.Size(m)
+}
+func (m *ExtensionRangeOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionRangeOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionRangeOptions proto.InternalMessageInfo
+
+const Default_ExtensionRangeOptions_Verification ExtensionRangeOptions_VerificationState = ExtensionRangeOptions_UNVERIFIED
+
+func (m *ExtensionRangeOptions) GetUninterpretedOption() []*UninterpretedOption {
+	if m != nil {
+		return m.UninterpretedOption
+	}
+	return nil
+}
+
+func (m *ExtensionRangeOptions) GetDeclaration() []*ExtensionRangeOptions_Declaration {
+	if m != nil {
+		return m.Declaration
+	}
+	return nil
+}
+
+func (m *ExtensionRangeOptions) GetFeatures() *FeatureSet {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func (m *ExtensionRangeOptions) GetVerification() ExtensionRangeOptions_VerificationState {
+	if m != nil && m.Verification != nil {
+		return *m.Verification
+	}
+	return Default_ExtensionRangeOptions_Verification
+}
+
+type ExtensionRangeOptions_Declaration struct {
+	// The extension number declared within the extension range.
+	Number *int32 `protobuf:"varint,1,opt,name=number" json:"number,omitempty"`
+	// The fully-qualified name of the extension field. There must be a leading
+	// dot in front of the full name.
+	FullName *string `protobuf:"bytes,2,opt,name=full_name,json=fullName" json:"full_name,omitempty"`
+	// The fully-qualified type name of the extension field. Unlike
+	// Metadata.type, Declaration.type must have a leading dot for messages
+	// and enums.
+	Type *string `protobuf:"bytes,3,opt,name=type" json:"type,omitempty"`
+	// If true, indicates that the number is reserved in the extension range,
+	// and any extension field with the number will fail to compile. Set this
+	// when a declared extension field is deleted.
+	Reserved *bool `protobuf:"varint,5,opt,name=reserved" json:"reserved,omitempty"`
+	// If true, indicates that the extension must be defined as repeated.
+	// Otherwise the extension must be defined as optional.
+	Repeated             *bool    `protobuf:"varint,6,opt,name=repeated" json:"repeated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExtensionRangeOptions_Declaration) Reset()         { *m = ExtensionRangeOptions_Declaration{} }
+func (m *ExtensionRangeOptions_Declaration) String() string { return proto.CompactTextString(m) }
+func (*ExtensionRangeOptions_Declaration) ProtoMessage()    {}
+func (*ExtensionRangeOptions_Declaration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{3, 0}
+}
+func (m *ExtensionRangeOptions_Declaration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtensionRangeOptions_Declaration.Unmarshal(m, b)
+}
+func (m *ExtensionRangeOptions_Declaration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtensionRangeOptions_Declaration.Marshal(b, m, deterministic)
+}
+func (m *ExtensionRangeOptions_Declaration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionRangeOptions_Declaration.Merge(m, src)
+}
+func (m *ExtensionRangeOptions_Declaration) XXX_Size() int {
+	return xxx_messageInfo_ExtensionRangeOptions_Declaration.Size(m)
+}
+func (m *ExtensionRangeOptions_Declaration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionRangeOptions_Declaration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionRangeOptions_Declaration proto.InternalMessageInfo
+
+func (m *ExtensionRangeOptions_Declaration) GetNumber() int32 {
+	if m != nil && m.Number != nil {
+		return *m.Number
+	}
+	return 0
+}
+
+func (m *ExtensionRangeOptions_Declaration) GetFullName() string {
+	if m != nil && m.FullName != nil {
+		return *m.FullName
+	}
+	return ""
+}
+
+func (m *ExtensionRangeOptions_Declaration) GetType() string {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return ""
+}
+
+func (m *ExtensionRangeOptions_Declaration) GetReserved() bool {
+	if m != nil && m.Reserved != nil {
+		return *m.Reserved
+	}
+	return false
+}
+
+func (m *ExtensionRangeOptions_Declaration) GetRepeated() bool {
+	if m != nil && m.Repeated != nil {
+		return *m.Repeated
+	}
+	return false
+}
+
 // Describes a field within a message.
 type FieldDescriptorProto struct {
 	Name   *string                     `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
@@ -473,7 +1486,7 @@ type FieldDescriptorProto struct {
 	// rules are used to find the type (i.e. first the nested types within this
 	// message are searched, then within the parent, on up to the root
 	// namespace).
-	TypeName *string `protobuf:"bytes,6,opt,name=type_name" json:"type_name,omitempty"`
+	TypeName *string `protobuf:"bytes,6,opt,name=type_name,json=typeName" json:"type_name,omitempty"`
 	// For extensions, this is the name of the type being extended.  It is
 	// resolved in the same manner as type_name.
 	Extendee *string `protobuf:"bytes,2,opt,name=extendee" json:"extendee,omitempty"`
@@ -481,20 +1494,66 @@ type FieldDescriptorProto struct {
 	// For booleans, "true" or "false".
 	// For strings, contains the default text contents (not escaped in any way).
 	// For bytes, contains the C escaped value.  All bytes >= 128 are escaped.
-	// TODO(kenton):  Base-64 encode?
-	DefaultValue *string `protobuf:"bytes,7,opt,name=default_value" json:"default_value,omitempty"`
+	DefaultValue *string `protobuf:"bytes,7,opt,name=default_value,json=defaultValue" json:"default_value,omitempty"`
 	// If set, gives the index of a oneof in the containing type's oneof_decl
-	// list.  This field is a member of that oneof.  Extensions of a oneof should
-	// not set this since the oneof to which they belong will be inferred based
-	// on the extension range containing the extension's field number.
-	OneofIndex       *int32        `protobuf:"varint,9,opt,name=oneof_index" json:"oneof_index,omitempty"`
-	Options          *FieldOptions `protobuf:"bytes,8,opt,name=options" json:"options,omitempty"`
-	XXX_unrecognized []byte        `json:"-"`
+	// list.  This field is a member of that oneof.
+	OneofIndex *int32 `protobuf:"varint,9,opt,name=oneof_index,json=oneofIndex" json:"oneof_index,omitempty"`
+	// JSON name of this field. The value is set by protocol compiler. If the
+	// user has set a "json_name" option on this field, that option's value
+	// will be used. Otherwise, it's deduced from the field's name by converting
+	// it to camelCase.
+	JsonName *string       `protobuf:"bytes,10,opt,name=json_name,json=jsonName" json:"json_name,omitempty"`
+	Options  *FieldOptions `protobuf:"bytes,8,opt,name=options" json:"options,omitempty"`
+	// If true, this is a proto3 "optional". When a proto3 field is optional, it
+	// tracks presence regardless of field type.
+	//
+	// When proto3_optional is true, this field must belong to a oneof to signal
+	// to old proto3 clients that presence is tracked for this field. This oneof
+	// is known as a "synthetic" oneof, and this field must be its sole member
+	// (each proto3 optional field gets its own synthetic oneof). Synthetic oneofs
+	// exist in the descriptor only, and do not generate any API. Synthetic oneofs
+	// must be ordered after all "real" oneofs.
+	//
+	// For message fields, proto3_optional doesn't create any semantic change,
+	// since non-repeated message fields always track presence. However it still
+	// indicates the semantic detail of whether the user wrote "optional" or not.
+	// This can be useful for round-tripping the .proto file. For consistency we
+	// give message fields a synthetic oneof also, even though it is not required
+	// to track presence. This is especially important because the parser can't
+	// tell if a field is a message or an enum, so it must always create a
+	// synthetic oneof.
+	//
+	// Proto2 optional fields do not set this flag, because they already indicate
+	// optional with `LABEL_OPTIONAL`.
+	Proto3Optional       *bool    `protobuf:"varint,17,opt,name=proto3_optional,json=proto3Optional" json:"proto3_optional,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *FieldDescriptorProto) Reset()         { *m = FieldDescriptorProto{} }
 func (m *FieldDescriptorProto) String() string { return proto.CompactTextString(m) }
 func (*FieldDescriptorProto) ProtoMessage()    {}
+func (*FieldDescriptorProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{4}
+}
+func (m *FieldDescriptorProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldDescriptorProto.Unmarshal(m, b)
+}
+func (m *FieldDescriptorProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldDescriptorProto.Marshal(b, m, deterministic)
+}
+func (m *FieldDescriptorProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldDescriptorProto.Merge(m, src)
+}
+func (m *FieldDescriptorProto) XXX_Size() int {
+	return xxx_messageInfo_FieldDescriptorProto.Size(m)
+}
+func (m *FieldDescriptorProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldDescriptorProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldDescriptorProto proto.InternalMessageInfo
 
 func (m *FieldDescriptorProto) GetName() string {
 	if m != nil && m.Name != nil {
@@ -552,6 +1611,13 @@ func (m *FieldDescriptorProto) GetOneofIndex() int32 {
 	return 0
 }
 
+func (m *FieldDescriptorProto) GetJsonName() string {
+	if m != nil && m.JsonName != nil {
+		return *m.JsonName
+	}
+	return ""
+}
+
 func (m *FieldDescriptorProto) GetOptions() *FieldOptions {
 	if m != nil {
 		return m.Options
@@ -559,15 +1625,45 @@ func (m *FieldDescriptorProto) GetOptions() *FieldOptions {
 	return nil
 }
 
+func (m *FieldDescriptorProto) GetProto3Optional() bool {
+	if m != nil && m.Proto3Optional != nil {
+		return *m.Proto3Optional
+	}
+	return false
+}
+
 // Describes a oneof.
 type OneofDescriptorProto struct {
-	Name             *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	XXX_unrecognized []byte  `json:"-"`
+	Name                 *string       `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Options              *OneofOptions `protobuf:"bytes,2,opt,name=options" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *OneofDescriptorProto) Reset()         { *m = OneofDescriptorProto{} }
 func (m *OneofDescriptorProto) String() string { return proto.CompactTextString(m) }
 func (*OneofDescriptorProto) ProtoMessage()    {}
+func (*OneofDescriptorProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{5}
+}
+func (m *OneofDescriptorProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OneofDescriptorProto.Unmarshal(m, b)
+}
+func (m *OneofDescriptorProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OneofDescriptorProto.Marshal(b, m, deterministic)
+}
+func (m *OneofDescriptorProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OneofDescriptorProto.Merge(m, src)
+}
+func (m *OneofDescriptorProto) XXX_Size() int {
+	return xxx_messageInfo_OneofDescriptorProto.Size(m)
+}
+func (m *OneofDescriptorProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_OneofDescriptorProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OneofDescriptorProto proto.InternalMessageInfo
 
 func (m *OneofDescriptorProto) GetName() string {
 	if m != nil && m.Name != nil {
@@ -576,17 +1672,53 @@ func (m *OneofDescriptorProto) GetName() string {
 	return ""
 }
 
+func (m *OneofDescriptorProto) GetOptions() *OneofOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 // Describes an enum type.
 type EnumDescriptorProto struct {
-	Name             *string                     `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Value            []*EnumValueDescriptorProto `protobuf:"bytes,2,rep,name=value" json:"value,omitempty"`
-	Options          *EnumOptions                `protobuf:"bytes,3,opt,name=options" json:"options,omitempty"`
-	XXX_unrecognized []byte                      `json:"-"`
+	Name    *string                     `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Value   []*EnumValueDescriptorProto `protobuf:"bytes,2,rep,name=value" json:"value,omitempty"`
+	Options *EnumOptions                `protobuf:"bytes,3,opt,name=options" json:"options,omitempty"`
+	// Range of reserved numeric values. Reserved numeric values may not be used
+	// by enum values in the same enum declaration. Reserved ranges may not
+	// overlap.
+	ReservedRange []*EnumDescriptorProto_EnumReservedRange `protobuf:"bytes,4,rep,name=reserved_range,json=reservedRange" json:"reserved_range,omitempty"`
+	// Reserved enum value names, which may not be reused. A given name may only
+	// be reserved once.
+	ReservedName         []string `protobuf:"bytes,5,rep,name=reserved_name,json=reservedName" json:"reserved_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *EnumDescriptorProto) Reset()         { *m = EnumDescriptorProto{} }
 func (m *EnumDescriptorProto) String() string { return proto.CompactTextString(m) }
 func (*EnumDescriptorProto) ProtoMessage()    {}
+func (*EnumDescriptorProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{6}
+}
+func (m *EnumDescriptorProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnumDescriptorProto.Unmarshal(m, b)
+}
+func (m *EnumDescriptorProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnumDescriptorProto.Marshal(b, m, deterministic)
+}
+func (m *EnumDescriptorProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnumDescriptorProto.Merge(m, src)
+}
+func (m *EnumDescriptorProto) XXX_Size() int {
+	return xxx_messageInfo_EnumDescriptorProto.Size(m)
+}
+func (m *EnumDescriptorProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnumDescriptorProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnumDescriptorProto proto.InternalMessageInfo
 
 func (m *EnumDescriptorProto) GetName() string {
 	if m != nil && m.Name != nil {
@@ -609,17 +1741,105 @@ func (m *EnumDescriptorProto) GetOptions() *EnumOptions {
 	return nil
 }
 
+func (m *EnumDescriptorProto) GetReservedRange() []*EnumDescriptorProto_EnumReservedRange {
+	if m != nil {
+		return m.ReservedRange
+	}
+	return nil
+}
+
+func (m *EnumDescriptorProto) GetReservedName() []string {
+	if m != nil {
+		return m.ReservedName
+	}
+	return nil
+}
+
+// Range of reserved numeric values. Reserved values may not be used by
+// entries in the same enum. Reserved ranges may not overlap.
+//
+// Note that this is distinct from DescriptorProto.ReservedRange in that it
+// is inclusive such that it can appropriately represent the entire int32
+// domain.
+type EnumDescriptorProto_EnumReservedRange struct {
+	Start                *int32   `protobuf:"varint,1,opt,name=start" json:"start,omitempty"`
+	End                  *int32   `protobuf:"varint,2,opt,name=end" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnumDescriptorProto_EnumReservedRange) Reset()         { *m = EnumDescriptorProto_EnumReservedRange{} }
+func (m *EnumDescriptorProto_EnumReservedRange) String() string { return proto.CompactTextString(m) }
+func (*EnumDescriptorProto_EnumReservedRange) ProtoMessage()    {}
+func (*EnumDescriptorProto_EnumReservedRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{6, 0}
+}
+func (m *EnumDescriptorProto_EnumReservedRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnumDescriptorProto_EnumReservedRange.Unmarshal(m, b)
+}
+func (m *EnumDescriptorProto_EnumReservedRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnumDescriptorProto_EnumReservedRange.Marshal(b, m, deterministic)
+}
+func (m *EnumDescriptorProto_EnumReservedRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnumDescriptorProto_EnumReservedRange.Merge(m, src)
+}
+func (m *EnumDescriptorProto_EnumReservedRange) XXX_Size() int {
+	return xxx_messageInfo_EnumDescriptorProto_EnumReservedRange.Size(m)
+}
+func (m *EnumDescriptorProto_EnumReservedRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnumDescriptorProto_EnumReservedRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnumDescriptorProto_EnumReservedRange proto.InternalMessageInfo
+
+func (m *EnumDescriptorProto_EnumReservedRange) GetStart() int32 {
+	if m != nil && m.Start != nil {
+		return *m.Start
+	}
+	return 0
+}
+
+func (m *EnumDescriptorProto_EnumReservedRange) GetEnd() int32 {
+	if m != nil && m.End != nil {
+		return *m.End
+	}
+	return 0
+}
+
 // Describes a value within an enum.
 type EnumValueDescriptorProto struct {
-	Name             *string           `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Number           *int32            `protobuf:"varint,2,opt,name=number" json:"number,omitempty"`
-	Options          *EnumValueOptions `protobuf:"bytes,3,opt,name=options" json:"options,omitempty"`
-	XXX_unrecognized []byte            `json:"-"`
+	Name                 *string           `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Number               *int32            `protobuf:"varint,2,opt,name=number" json:"number,omitempty"`
+	Options              *EnumValueOptions `protobuf:"bytes,3,opt,name=options" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *EnumValueDescriptorProto) Reset()         { *m = EnumValueDescriptorProto{} }
 func (m *EnumValueDescriptorProto) String() string { return proto.CompactTextString(m) }
 func (*EnumValueDescriptorProto) ProtoMessage()    {}
+func (*EnumValueDescriptorProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{7}
+}
+func (m *EnumValueDescriptorProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnumValueDescriptorProto.Unmarshal(m, b)
+}
+func (m *EnumValueDescriptorProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnumValueDescriptorProto.Marshal(b, m, deterministic)
+}
+func (m *EnumValueDescriptorProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnumValueDescriptorProto.Merge(m, src)
+}
+func (m *EnumValueDescriptorProto) XXX_Size() int {
+	return xxx_messageInfo_EnumValueDescriptorProto.Size(m)
+}
+func (m *EnumValueDescriptorProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnumValueDescriptorProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnumValueDescriptorProto proto.InternalMessageInfo
 
 func (m *EnumValueDescriptorProto) GetName() string {
 	if m != nil && m.Name != nil {
@@ -644,15 +1864,37 @@ func (m *EnumValueDescriptorProto) GetOptions() *EnumValueOptions {
 
 // Describes a service.
 type ServiceDescriptorProto struct {
-	Name             *string                  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Method           []*MethodDescriptorProto `protobuf:"bytes,2,rep,name=method" json:"method,omitempty"`
-	Options          *ServiceOptions          `protobuf:"bytes,3,opt,name=options" json:"options,omitempty"`
-	XXX_unrecognized []byte                   `json:"-"`
+	Name                 *string                  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Method               []*MethodDescriptorProto `protobuf:"bytes,2,rep,name=method" json:"method,omitempty"`
+	Options              *ServiceOptions          `protobuf:"bytes,3,opt,name=options" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *ServiceDescriptorProto) Reset()         { *m = ServiceDescriptorProto{} }
 func (m *ServiceDescriptorProto) String() string { return proto.CompactTextString(m) }
 func (*ServiceDescriptorProto) ProtoMessage()    {}
+func (*ServiceDescriptorProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{8}
+}
+func (m *ServiceDescriptorProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceDescriptorProto.Unmarshal(m, b)
+}
+func (m *ServiceDescriptorProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceDescriptorProto.Marshal(b, m, deterministic)
+}
+func (m *ServiceDescriptorProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceDescriptorProto.Merge(m, src)
+}
+func (m *ServiceDescriptorProto) XXX_Size() int {
+	return xxx_messageInfo_ServiceDescriptorProto.Size(m)
+}
+func (m *ServiceDescriptorProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceDescriptorProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceDescriptorProto proto.InternalMessageInfo
 
 func (m *ServiceDescriptorProto) GetName() string {
 	if m != nil && m.Name != nil {
@@ -680,15 +1922,44 @@ type MethodDescriptorProto struct {
 	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	// Input and output type names.  These are resolved in the same way as
 	// FieldDescriptorProto.type_name, but must refer to a message type.
-	InputType        *string        `protobuf:"bytes,2,opt,name=input_type" json:"input_type,omitempty"`
-	OutputType       *string        `protobuf:"bytes,3,opt,name=output_type" json:"output_type,omitempty"`
-	Options          *MethodOptions `protobuf:"bytes,4,opt,name=options" json:"options,omitempty"`
-	XXX_unrecognized []byte         `json:"-"`
+	InputType  *string        `protobuf:"bytes,2,opt,name=input_type,json=inputType" json:"input_type,omitempty"`
+	OutputType *string        `protobuf:"bytes,3,opt,name=output_type,json=outputType" json:"output_type,omitempty"`
+	Options    *MethodOptions `protobuf:"bytes,4,opt,name=options" json:"options,omitempty"`
+	// Identifies if client streams multiple client messages
+	ClientStreaming *bool `protobuf:"varint,5,opt,name=client_streaming,json=clientStreaming,def=0" json:"client_streaming,omitempty"`
+	// Identifies if server streams multiple server messages
+	ServerStreaming      *bool    `protobuf:"varint,6,opt,name=server_streaming,json=serverStreaming,def=0" json:"server_streaming,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MethodDescriptorProto) Reset()         { *m = MethodDescriptorProto{} }
 func (m *MethodDescriptorProto) String() string { return proto.CompactTextString(m) }
 func (*MethodDescriptorProto) ProtoMessage()    {}
+func (*MethodDescriptorProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{9}
+}
+func (m *MethodDescriptorProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MethodDescriptorProto.Unmarshal(m, b)
+}
+func (m *MethodDescriptorProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MethodDescriptorProto.Marshal(b, m, deterministic)
+}
+func (m *MethodDescriptorProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MethodDescriptorProto.Merge(m, src)
+}
+func (m *MethodDescriptorProto) XXX_Size() int {
+	return xxx_messageInfo_MethodDescriptorProto.Size(m)
+}
+func (m *MethodDescriptorProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_MethodDescriptorProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MethodDescriptorProto proto.InternalMessageInfo
+
+const Default_MethodDescriptorProto_ClientStreaming bool = false
+const Default_MethodDescriptorProto_ServerStreaming bool = false
 
 func (m *MethodDescriptorProto) GetName() string {
 	if m != nil && m.Name != nil {
@@ -718,97 +1989,157 @@ func (m *MethodDescriptorProto) GetOptions() *MethodOptions {
 	return nil
 }
 
+func (m *MethodDescriptorProto) GetClientStreaming() bool {
+	if m != nil && m.ClientStreaming != nil {
+		return *m.ClientStreaming
+	}
+	return Default_MethodDescriptorProto_ClientStreaming
+}
+
+func (m *MethodDescriptorProto) GetServerStreaming() bool {
+	if m != nil && m.ServerStreaming != nil {
+		return *m.ServerStreaming
+	}
+	return Default_MethodDescriptorProto_ServerStreaming
+}
+
 type FileOptions struct {
 	// Sets the Java package where classes generated from this .proto will be
 	// placed.  By default, the proto package is used, but this is often
 	// inappropriate because proto packages do not normally start with backwards
 	// domain names.
-	JavaPackage *string `protobuf:"bytes,1,opt,name=java_package" json:"java_package,omitempty"`
-	// If set, all the classes from the .proto file are wrapped in a single
-	// outer class with the given name.  This applies to both Proto1
-	// (equivalent to the old "--one_java_file" option) and Proto2 (where
-	// a .proto always translates to a single class, but you may want to
-	// explicitly choose the class name).
-	JavaOuterClassname *string `protobuf:"bytes,8,opt,name=java_outer_classname" json:"java_outer_classname,omitempty"`
-	// If set true, then the Java code generator will generate a separate .java
+	JavaPackage *string `protobuf:"bytes,1,opt,name=java_package,json=javaPackage" json:"java_package,omitempty"`
+	// Controls the name of the wrapper Java class generated for the .proto file.
+	// That class will always contain the .proto file's getDescriptor() method as
+	// well as any top-level extensions defined in the .proto file.
+	// If java_multiple_files is disabled, then all the other classes from the
+	// .proto file will be nested inside the single wrapper outer class.
+	JavaOuterClassname *string `protobuf:"bytes,8,opt,name=java_outer_classname,json=javaOuterClassname" json:"java_outer_classname,omitempty"`
+	// If enabled, then the Java code generator will generate a separate .java
 	// file for each top-level message, enum, and service defined in the .proto
-	// file.  Thus, these types will *not* be nested inside the outer class
-	// named by java_outer_classname.  However, the outer class will still be
+	// file.  Thus, these types will *not* be nested inside the wrapper class
+	// named by java_outer_classname.  However, the wrapper class will still be
 	// generated to contain the file's getDescriptor() method as well as any
 	// top-level extensions defined in the file.
-	JavaMultipleFiles *bool `protobuf:"varint,10,opt,name=java_multiple_files,def=0" json:"java_multiple_files,omitempty"`
-	// If set true, then the Java code generator will generate equals() and
-	// hashCode() methods for all messages defined in the .proto file.
-	// - In the full runtime, this is purely a speed optimization, as the
-	// AbstractMessage base class includes reflection-based implementations of
-	// these methods.
-	// - In the lite runtime, setting this option changes the semantics of
-	// equals() and hashCode() to more closely match those of the full runtime;
-	// the generated methods compute their results based on field values rather
-	// than object identity. (Implementations should not assume that hashcodes
-	// will be consistent across runtimes or versions of the protocol compiler.)
-	JavaGenerateEqualsAndHash *bool `protobuf:"varint,20,opt,name=java_generate_equals_and_hash,def=0" json:"java_generate_equals_and_hash,omitempty"`
-	// If set true, then the Java2 code generator will generate code that
-	// throws an exception whenever an attempt is made to assign a non-UTF-8
-	// byte sequence to a string field.
-	// Message reflection will do the same.
-	// However, an extension field still accepts non-UTF-8 byte sequences.
-	// This option has no effect on when used with the lite runtime.
-	JavaStringCheckUtf8 *bool                     `protobuf:"varint,27,opt,name=java_string_check_utf8,def=0" json:"java_string_check_utf8,omitempty"`
-	OptimizeFor         *FileOptions_OptimizeMode `protobuf:"varint,9,opt,name=optimize_for,enum=google.protobuf.FileOptions_OptimizeMode,def=1" json:"optimize_for,omitempty"`
+	JavaMultipleFiles *bool `protobuf:"varint,10,opt,name=java_multiple_files,json=javaMultipleFiles,def=0" json:"java_multiple_files,omitempty"`
+	// This option does nothing.
+	JavaGenerateEqualsAndHash *bool `protobuf:"varint,20,opt,name=java_generate_equals_and_hash,json=javaGenerateEqualsAndHash" json:"java_generate_equals_and_hash,omitempty"` // Deprecated: Do not use.
+	// A proto2 file can set this to true to opt in to UTF-8 checking for Java,
+	// which will throw an exception if invalid UTF-8 is parsed from the wire or
+	// assigned to a string field.
+	//
+	// TODO: clarify exactly what kinds of field types this option
+	// applies to, and update these docs accordingly.
+	//
+	// Proto3 files already perform these checks. Setting the option explicitly to
+	// false has no effect: it cannot be used to opt proto3 files out of UTF-8
+	// checks.
+	JavaStringCheckUtf8 *bool                     `protobuf:"varint,27,opt,name=java_string_check_utf8,json=javaStringCheckUtf8,def=0" json:"java_string_check_utf8,omitempty"`
+	OptimizeFor         *FileOptions_OptimizeMode `protobuf:"varint,9,opt,name=optimize_for,json=optimizeFor,enum=google.protobuf.FileOptions_OptimizeMode,def=1" json:"optimize_for,omitempty"`
 	// Sets the Go package where structs generated from this .proto will be
-	// placed.  There is no default.
-	GoPackage *string `protobuf:"bytes,11,opt,name=go_package" json:"go_package,omitempty"`
+	// placed. If omitted, the Go package will be derived from the following:
+	//   - The basename of the package import path, if provided.
+	//   - Otherwise, the package statement in the .proto file, if present.
+	//   - Otherwise, the basename of the .proto file, without extension.
+	GoPackage *string `protobuf:"bytes,11,opt,name=go_package,json=goPackage" json:"go_package,omitempty"`
 	// Should generic services be generated in each language?  "Generic" services
 	// are not specific to any particular RPC system.  They are generated by the
 	// main code generators in each language (without additional plugins).
 	// Generic services were the only kind of service generation supported by
-	// early versions of proto2.
+	// early versions of google.protobuf.
 	//
 	// Generic services are now considered deprecated in favor of using plugins
 	// that generate code specific to your particular RPC system.  Therefore,
 	// these default to false.  Old code which depends on generic services should
 	// explicitly set them to true.
-	CcGenericServices   *bool `protobuf:"varint,16,opt,name=cc_generic_services,def=0" json:"cc_generic_services,omitempty"`
-	JavaGenericServices *bool `protobuf:"varint,17,opt,name=java_generic_services,def=0" json:"java_generic_services,omitempty"`
-	PyGenericServices   *bool `protobuf:"varint,18,opt,name=py_generic_services,def=0" json:"py_generic_services,omitempty"`
+	CcGenericServices   *bool `protobuf:"varint,16,opt,name=cc_generic_services,json=ccGenericServices,def=0" json:"cc_generic_services,omitempty"`
+	JavaGenericServices *bool `protobuf:"varint,17,opt,name=java_generic_services,json=javaGenericServices,def=0" json:"java_generic_services,omitempty"`
+	PyGenericServices   *bool `protobuf:"varint,18,opt,name=py_generic_services,json=pyGenericServices,def=0" json:"py_generic_services,omitempty"`
 	// Is this file deprecated?
 	// Depending on the target platform, this can emit Deprecated annotations
 	// for everything in the file, or it will be completely ignored; in the very
 	// least, this is a formalization for deprecating files.
 	Deprecated *bool `protobuf:"varint,23,opt,name=deprecated,def=0" json:"deprecated,omitempty"`
-	// The parser stores options it doesn't recognize here. See above.
-	UninterpretedOption []*UninterpretedOption    `protobuf:"bytes,999,rep,name=uninterpreted_option" json:"uninterpreted_option,omitempty"`
-	XXX_extensions      map[int32]proto.Extension `json:"-"`
-	XXX_unrecognized    []byte                    `json:"-"`
+	// Enables the use of arenas for the proto messages in this file. This applies
+	// only to generated classes for C++.
+	CcEnableArenas *bool `protobuf:"varint,31,opt,name=cc_enable_arenas,json=ccEnableArenas,def=1" json:"cc_enable_arenas,omitempty"`
+	// Sets the objective c class prefix which is prepended to all objective c
+	// generated classes from this .proto. There is no default.
+	ObjcClassPrefix *string `protobuf:"bytes,36,opt,name=objc_class_prefix,json=objcClassPrefix" json:"objc_class_prefix,omitempty"`
+	// Namespace for generated classes; defaults to the package.
+	CsharpNamespace *string `protobuf:"bytes,37,opt,name=csharp_namespace,json=csharpNamespace" json:"csharp_namespace,omitempty"`
+	// By default Swift generators will take the proto package and CamelCase it
+	// replacing '.' with underscore and use that to prefix the types/symbols
+	// defined. When this options is provided, they will use this value instead
+	// to prefix the types/symbols defined.
+	SwiftPrefix *string `protobuf:"bytes,39,opt,name=swift_prefix,json=swiftPrefix" json:"swift_prefix,omitempty"`
+	// Sets the php class prefix which is prepended to all php generated classes
+	// from this .proto. Default is empty.
+	PhpClassPrefix *string `protobuf:"bytes,40,opt,name=php_class_prefix,json=phpClassPrefix" json:"php_class_prefix,omitempty"`
+	// Use this option to change the namespace of php generated classes. Default
+	// is empty. When this option is empty, the package name will be used for
+	// determining the namespace.
+	PhpNamespace *string `protobuf:"bytes,41,opt,name=php_namespace,json=phpNamespace" json:"php_namespace,omitempty"`
+	// Use this option to change the namespace of php generated metadata classes.
+	// Default is empty. When this option is empty, the proto file name will be
+	// used for determining the namespace.
+	PhpMetadataNamespace *string `protobuf:"bytes,44,opt,name=php_metadata_namespace,json=phpMetadataNamespace" json:"php_metadata_namespace,omitempty"`
+	// Use this option to change the package of ruby generated classes. Default
+	// is empty. When this option is not set, the package name will be used for
+	// determining the ruby package.
+	RubyPackage *string `protobuf:"bytes,45,opt,name=ruby_package,json=rubyPackage" json:"ruby_package,omitempty"`
+	// Any features defined in the specific edition.
+	Features *FeatureSet `protobuf:"bytes,50,opt,name=features" json:"features,omitempty"`
+	// The parser stores options it doesn't recognize here.
+	// See the documentation for the "Options" section above.
+	UninterpretedOption          []*UninterpretedOption `protobuf:"bytes,999,rep,name=uninterpreted_option,json=uninterpretedOption" json:"uninterpreted_option,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}               `json:"-"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
 }
 
 func (m *FileOptions) Reset()         { *m = FileOptions{} }
 func (m *FileOptions) String() string { return proto.CompactTextString(m) }
 func (*FileOptions) ProtoMessage()    {}
+func (*FileOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{10}
+}
 
 var extRange_FileOptions = []proto.ExtensionRange{
-	{1000, 536870911},
+	{Start: 1000, End: 536870911},
 }
 
 func (*FileOptions) ExtensionRangeArray() []proto.ExtensionRange {
 	return extRange_FileOptions
 }
-func (m *FileOptions) ExtensionMap() map[int32]proto.Extension {
-	if m.XXX_extensions == nil {
-		m.XXX_extensions = make(map[int32]proto.Extension)
-	}
-	return m.XXX_extensions
+
+func (m *FileOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileOptions.Unmarshal(m, b)
+}
+func (m *FileOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileOptions.Marshal(b, m, deterministic)
+}
+func (m *FileOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileOptions.Merge(m, src)
+}
+func (m *FileOptions) XXX_Size() int {
+	return xxx_messageInfo_FileOptions.Size(m)
+}
+func (m *FileOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileOptions.DiscardUnknown(m)
 }
 
+var xxx_messageInfo_FileOptions proto.InternalMessageInfo
+
 const Default_FileOptions_JavaMultipleFiles bool = false
-const Default_FileOptions_JavaGenerateEqualsAndHash bool = false
 const Default_FileOptions_JavaStringCheckUtf8 bool = false
 const Default_FileOptions_OptimizeFor FileOptions_OptimizeMode = FileOptions_SPEED
 const Default_FileOptions_CcGenericServices bool = false
 const Default_FileOptions_JavaGenericServices bool = false
 const Default_FileOptions_PyGenericServices bool = false
 const Default_FileOptions_Deprecated bool = false
+const Default_FileOptions_CcEnableArenas bool = true
 
 func (m *FileOptions) GetJavaPackage() string {
 	if m != nil && m.JavaPackage != nil {
@@ -831,11 +2162,12 @@ func (m *FileOptions) GetJavaMultipleFiles() bool {
 	return Default_FileOptions_JavaMultipleFiles
 }
 
+// Deprecated: Do not use.
 func (m *FileOptions) GetJavaGenerateEqualsAndHash() bool {
 	if m != nil && m.JavaGenerateEqualsAndHash != nil {
 		return *m.JavaGenerateEqualsAndHash
 	}
-	return Default_FileOptions_JavaGenerateEqualsAndHash
+	return false
 }
 
 func (m *FileOptions) GetJavaStringCheckUtf8() bool {
@@ -887,6 +2219,69 @@ func (m *FileOptions) GetDeprecated() bool {
 	return Default_FileOptions_Deprecated
 }
 
+func (m *FileOptions) GetCcEnableArenas() bool {
+	if m != nil && m.CcEnableArenas != nil {
+		return *m.CcEnableArenas
+	}
+	return Default_FileOptions_CcEnableArenas
+}
+
+func (m *FileOptions) GetObjcClassPrefix() string {
+	if m != nil && m.ObjcClassPrefix != nil {
+		return *m.ObjcClassPrefix
+	}
+	return ""
+}
+
+func (m *FileOptions) GetCsharpNamespace() string {
+	if m != nil && m.CsharpNamespace != nil {
+		return *m.CsharpNamespace
+	}
+	return ""
+}
+
+func (m *FileOptions) GetSwiftPrefix() string {
+	if m != nil && m.SwiftPrefix != nil {
+		return *m.SwiftPrefix
+	}
+	return ""
+}
+
+func (m *FileOptions) GetPhpClassPrefix() string {
+	if m != nil && m.PhpClassPrefix != nil {
+		return *m.PhpClassPrefix
+	}
+	return ""
+}
+
+func (m *FileOptions) GetPhpNamespace() string {
+	if m != nil && m.PhpNamespace != nil {
+		return *m.PhpNamespace
+	}
+	return ""
+}
+
+func (m *FileOptions) GetPhpMetadataNamespace() string {
+	if m != nil && m.PhpMetadataNamespace != nil {
+		return *m.PhpMetadataNamespace
+	}
+	return ""
+}
+
+func (m *FileOptions) GetRubyPackage() string {
+	if m != nil && m.RubyPackage != nil {
+		return *m.RubyPackage
+	}
+	return ""
+}
+
+func (m *FileOptions) GetFeatures() *FeatureSet {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
 func (m *FileOptions) GetUninterpretedOption() []*UninterpretedOption {
 	if m != nil {
 		return m.UninterpretedOption
@@ -913,40 +2308,92 @@ type MessageOptions struct {
 	//
 	// Because this is an option, the above two restrictions are not enforced by
 	// the protocol compiler.
-	MessageSetWireFormat *bool `protobuf:"varint,1,opt,name=message_set_wire_format,def=0" json:"message_set_wire_format,omitempty"`
+	MessageSetWireFormat *bool `protobuf:"varint,1,opt,name=message_set_wire_format,json=messageSetWireFormat,def=0" json:"message_set_wire_format,omitempty"`
 	// Disables the generation of the standard "descriptor()" accessor, which can
 	// conflict with a field of the same name.  This is meant to make migration
 	// from proto1 easier; new code should avoid fields named "descriptor".
-	NoStandardDescriptorAccessor *bool `protobuf:"varint,2,opt,name=no_standard_descriptor_accessor,def=0" json:"no_standard_descriptor_accessor,omitempty"`
+	NoStandardDescriptorAccessor *bool `protobuf:"varint,2,opt,name=no_standard_descriptor_accessor,json=noStandardDescriptorAccessor,def=0" json:"no_standard_descriptor_accessor,omitempty"`
 	// Is this message deprecated?
 	// Depending on the target platform, this can emit Deprecated annotations
 	// for the message, or it will be completely ignored; in the very least,
 	// this is a formalization for deprecating messages.
 	Deprecated *bool `protobuf:"varint,3,opt,name=deprecated,def=0" json:"deprecated,omitempty"`
+	// Whether the message is an automatically generated map entry type for the
+	// maps field.
+	//
+	// For maps fields:
+	//     map<KeyType, ValueType> map_field = 1;
+	// The parsed descriptor looks like:
+	//     message MapFieldEntry {
+	//         option map_entry = true;
+	//         optional KeyType key = 1;
+	//         optional ValueType value = 2;
+	//     }
+	//     repeated MapFieldEntry map_field = 1;
+	//
+	// Implementations may choose not to generate the map_entry=true message, but
+	// use a native map in the target language to hold the keys and values.
+	// The reflection APIs in such implementations still need to work as
+	// if the field is a repeated message field.
+	//
+	// NOTE: Do not set the option in .proto files. Always use the maps syntax
+	// instead. The option should only be implicitly set by the proto compiler
+	// parser.
+	MapEntry *bool `protobuf:"varint,7,opt,name=map_entry,json=mapEntry" json:"map_entry,omitempty"`
+	// Enable the legacy handling of JSON field name conflicts.  This lowercases
+	// and strips underscored from the fields before comparison in proto3 only.
+	// The new behavior takes `json_name` into account and applies to proto2 as
+	// well.
+	//
+	// This should only be used as a temporary measure against broken builds due
+	// to the change in behavior for JSON field name conflicts.
+	//
+	// TODO This is legacy behavior we plan to remove once downstream
+	// teams have had time to migrate.
+	DeprecatedLegacyJsonFieldConflicts *bool `protobuf:"varint,11,opt,name=deprecated_legacy_json_field_conflicts,json=deprecatedLegacyJsonFieldConflicts" json:"deprecated_legacy_json_field_conflicts,omitempty"` // Deprecated: Do not use.
+	// Any features defined in the specific edition.
+	Features *FeatureSet `protobuf:"bytes,12,opt,name=features" json:"features,omitempty"`
 	// The parser stores options it doesn't recognize here. See above.
-	UninterpretedOption []*UninterpretedOption    `protobuf:"bytes,999,rep,name=uninterpreted_option" json:"uninterpreted_option,omitempty"`
-	XXX_extensions      map[int32]proto.Extension `json:"-"`
-	XXX_unrecognized    []byte                    `json:"-"`
+	UninterpretedOption          []*UninterpretedOption `protobuf:"bytes,999,rep,name=uninterpreted_option,json=uninterpretedOption" json:"uninterpreted_option,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}               `json:"-"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
 }
 
 func (m *MessageOptions) Reset()         { *m = MessageOptions{} }
 func (m *MessageOptions) String() string { return proto.CompactTextString(m) }
 func (*MessageOptions) ProtoMessage()    {}
+func (*MessageOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{11}
+}
 
 var extRange_MessageOptions = []proto.ExtensionRange{
-	{1000, 536870911},
+	{Start: 1000, End: 536870911},
 }
 
 func (*MessageOptions) ExtensionRangeArray() []proto.ExtensionRange {
 	return extRange_MessageOptions
 }
-func (m *MessageOptions) ExtensionMap() map[int32]proto.Extension {
-	if m.XXX_extensions == nil {
-		m.XXX_extensions = make(map[int32]proto.Extension)
-	}
-	return m.XXX_extensions
+
+func (m *MessageOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MessageOptions.Unmarshal(m, b)
+}
+func (m *MessageOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MessageOptions.Marshal(b, m, deterministic)
+}
+func (m *MessageOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageOptions.Merge(m, src)
+}
+func (m *MessageOptions) XXX_Size() int {
+	return xxx_messageInfo_MessageOptions.Size(m)
+}
+func (m *MessageOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageOptions.DiscardUnknown(m)
 }
 
+var xxx_messageInfo_MessageOptions proto.InternalMessageInfo
+
 const Default_MessageOptions_MessageSetWireFormat bool = false
 const Default_MessageOptions_NoStandardDescriptorAccessor bool = false
 const Default_MessageOptions_Deprecated bool = false
@@ -972,6 +2419,28 @@ func (m *MessageOptions) GetDeprecated() bool {
 	return Default_MessageOptions_Deprecated
 }
 
+func (m *MessageOptions) GetMapEntry() bool {
+	if m != nil && m.MapEntry != nil {
+		return *m.MapEntry
+	}
+	return false
+}
+
+// Deprecated: Do not use.
+func (m *MessageOptions) GetDeprecatedLegacyJsonFieldConflicts() bool {
+	if m != nil && m.DeprecatedLegacyJsonFieldConflicts != nil {
+		return *m.DeprecatedLegacyJsonFieldConflicts
+	}
+	return false
+}
+
+func (m *MessageOptions) GetFeatures() *FeatureSet {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
 func (m *MessageOptions) GetUninterpretedOption() []*UninterpretedOption {
 	if m != nil {
 		return m.UninterpretedOption
@@ -982,14 +2451,31 @@ func (m *MessageOptions) GetUninterpretedOption() []*UninterpretedOption {
 type FieldOptions struct {
 	// The ctype option instructs the C++ code generator to use a different
 	// representation of the field than it normally would.  See the specific
-	// options below.  This option is not yet implemented in the open source
-	// release -- sorry, we'll try to include it in a future version!
+	// options below.  This option is only implemented to support use of
+	// [ctype=CORD] and [ctype=STRING] (the default) on non-repeated fields of
+	// type "bytes" in the open source release -- sorry, we'll try to include
+	// other types in a future version!
 	Ctype *FieldOptions_CType `protobuf:"varint,1,opt,name=ctype,enum=google.protobuf.FieldOptions_CType,def=0" json:"ctype,omitempty"`
 	// The packed option can be enabled for repeated primitive fields to enable
 	// a more efficient representation on the wire. Rather than repeatedly
 	// writing the tag and type for each element, the entire array is encoded as
-	// a single length-delimited blob.
+	// a single length-delimited blob. In proto3, only explicit setting it to
+	// false will avoid using packed encoding.  This option is prohibited in
+	// Editions, but the `repeated_field_encoding` feature can be used to control
+	// the behavior.
 	Packed *bool `protobuf:"varint,2,opt,name=packed" json:"packed,omitempty"`
+	// The jstype option determines the JavaScript type used for values of the
+	// field.  The option is permitted only for 64 bit integral and fixed types
+	// (int64, uint64, sint64, fixed64, sfixed64).  A field with jstype JS_STRING
+	// is represented as JavaScript string, which avoids loss of precision that
+	// can happen when a large value is converted to a floating point JavaScript.
+	// Specifying JS_NUMBER for the jstype causes the generated JavaScript code to
+	// use the JavaScript "number" type.  The behavior of the default option
+	// JS_NORMAL is implementation dependent.
+	//
+	// This option is an enum to permit additional types to be added, e.g.
+	// goog.math.Integer.
+	Jstype *FieldOptions_JSType `protobuf:"varint,6,opt,name=jstype,enum=google.protobuf.FieldOptions_JSType,def=0" json:"jstype,omitempty"`
 	// Should this field be parsed lazily?  Lazy applies only to message-type
 	// fields.  It means that when the outer message is initially parsed, the
 	// inner message's contents will not be parsed but instead stored in encoded
@@ -1007,66 +2493,80 @@ type FieldOptions struct {
 	// call from multiple threads concurrently, while non-const methods continue
 	// to require exclusive access.
 	//
-	//
-	// Note that implementations may choose not to check required fields within
-	// a lazy sub-message.  That is, calling IsInitialized() on the outher message
-	// may return true even if the inner message has missing required fields.
-	// This is necessary because otherwise the inner message would have to be
-	// parsed in order to perform the check, defeating the purpose of lazy
-	// parsing.  An implementation which chooses not to check required fields
-	// must be consistent about it.  That is, for any particular sub-message, the
-	// implementation must either *always* check its required fields, or *never*
-	// check its required fields, regardless of whether or not the message has
-	// been parsed.
+	// Note that lazy message fields are still eagerly verified to check
+	// ill-formed wireformat or missing required fields. Calling IsInitialized()
+	// on the outer message would fail if the inner message has missing required
+	// fields. Failed verification would result in parsing failure (except when
+	// uninitialized messages are acceptable).
 	Lazy *bool `protobuf:"varint,5,opt,name=lazy,def=0" json:"lazy,omitempty"`
+	// unverified_lazy does no correctness checks on the byte stream. This should
+	// only be used where lazy with verification is prohibitive for performance
+	// reasons.
+	UnverifiedLazy *bool `protobuf:"varint,15,opt,name=unverified_lazy,json=unverifiedLazy,def=0" json:"unverified_lazy,omitempty"`
 	// Is this field deprecated?
 	// Depending on the target platform, this can emit Deprecated annotations
 	// for accessors, or it will be completely ignored; in the very least, this
 	// is a formalization for deprecating fields.
 	Deprecated *bool `protobuf:"varint,3,opt,name=deprecated,def=0" json:"deprecated,omitempty"`
-	// EXPERIMENTAL.  DO NOT USE.
-	// For "map" fields, the name of the field in the enclosed type that
-	// is the key for this map.  For example, suppose we have:
-	//   message Item {
-	//     required string name = 1;
-	//     required string value = 2;
-	//   }
-	//   message Config {
-	//     repeated Item items = 1 [experimental_map_key="name"];
-	//   }
-	// In this situation, the map key for Item will be set to "name".
-	// TODO: Fully-implement this, then remove the "experimental_" prefix.
-	ExperimentalMapKey *string `protobuf:"bytes,9,opt,name=experimental_map_key" json:"experimental_map_key,omitempty"`
 	// For Google-internal migration only. Do not use.
 	Weak *bool `protobuf:"varint,10,opt,name=weak,def=0" json:"weak,omitempty"`
+	// Indicate that the field value should not be printed out when using debug
+	// formats, e.g. when the field contains sensitive credentials.
+	DebugRedact     *bool                           `protobuf:"varint,16,opt,name=debug_redact,json=debugRedact,def=0" json:"debug_redact,omitempty"`
+	Retention       *FieldOptions_OptionRetention   `protobuf:"varint,17,opt,name=retention,enum=google.protobuf.FieldOptions_OptionRetention" json:"retention,omitempty"`
+	Targets         []FieldOptions_OptionTargetType `protobuf:"varint,19,rep,name=targets,enum=google.protobuf.FieldOptions_OptionTargetType" json:"targets,omitempty"`
+	EditionDefaults []*FieldOptions_EditionDefault  `protobuf:"bytes,20,rep,name=edition_defaults,json=editionDefaults" json:"edition_defaults,omitempty"`
+	// Any features defined in the specific edition.
+	Features       *FeatureSet                  `protobuf:"bytes,21,opt,name=features" json:"features,omitempty"`
+	FeatureSupport *FieldOptions_FeatureSupport `protobuf:"bytes,22,opt,name=feature_support,json=featureSupport" json:"feature_support,omitempty"`
 	// The parser stores options it doesn't recognize here. See above.
-	UninterpretedOption []*UninterpretedOption    `protobuf:"bytes,999,rep,name=uninterpreted_option" json:"uninterpreted_option,omitempty"`
-	XXX_extensions      map[int32]proto.Extension `json:"-"`
-	XXX_unrecognized    []byte                    `json:"-"`
+	UninterpretedOption          []*UninterpretedOption `protobuf:"bytes,999,rep,name=uninterpreted_option,json=uninterpretedOption" json:"uninterpreted_option,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}               `json:"-"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
 }
 
 func (m *FieldOptions) Reset()         { *m = FieldOptions{} }
 func (m *FieldOptions) String() string { return proto.CompactTextString(m) }
 func (*FieldOptions) ProtoMessage()    {}
+func (*FieldOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{12}
+}
 
 var extRange_FieldOptions = []proto.ExtensionRange{
-	{1000, 536870911},
+	{Start: 1000, End: 536870911},
 }
 
 func (*FieldOptions) ExtensionRangeArray() []proto.ExtensionRange {
 	return extRange_FieldOptions
 }
-func (m *FieldOptions) ExtensionMap() map[int32]proto.Extension {
-	if m.XXX_extensions == nil {
-		m.XXX_extensions = make(map[int32]proto.Extension)
-	}
-	return m.XXX_extensions
+
+func (m *FieldOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldOptions.Unmarshal(m, b)
+}
+func (m *FieldOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldOptions.Marshal(b, m, deterministic)
 }
+func (m *FieldOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldOptions.Merge(m, src)
+}
+func (m *FieldOptions) XXX_Size() int {
+	return xxx_messageInfo_FieldOptions.Size(m)
+}
+func (m *FieldOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldOptions proto.InternalMessageInfo
 
 const Default_FieldOptions_Ctype FieldOptions_CType = FieldOptions_STRING
+const Default_FieldOptions_Jstype FieldOptions_JSType = FieldOptions_JS_NORMAL
 const Default_FieldOptions_Lazy bool = false
+const Default_FieldOptions_UnverifiedLazy bool = false
 const Default_FieldOptions_Deprecated bool = false
 const Default_FieldOptions_Weak bool = false
+const Default_FieldOptions_DebugRedact bool = false
 
 func (m *FieldOptions) GetCtype() FieldOptions_CType {
 	if m != nil && m.Ctype != nil {
@@ -1082,6 +2582,13 @@ func (m *FieldOptions) GetPacked() bool {
 	return false
 }
 
+func (m *FieldOptions) GetJstype() FieldOptions_JSType {
+	if m != nil && m.Jstype != nil {
+		return *m.Jstype
+	}
+	return Default_FieldOptions_Jstype
+}
+
 func (m *FieldOptions) GetLazy() bool {
 	if m != nil && m.Lazy != nil {
 		return *m.Lazy
@@ -1089,28 +2596,247 @@ func (m *FieldOptions) GetLazy() bool {
 	return Default_FieldOptions_Lazy
 }
 
-func (m *FieldOptions) GetDeprecated() bool {
-	if m != nil && m.Deprecated != nil {
-		return *m.Deprecated
-	}
-	return Default_FieldOptions_Deprecated
+func (m *FieldOptions) GetUnverifiedLazy() bool {
+	if m != nil && m.UnverifiedLazy != nil {
+		return *m.UnverifiedLazy
+	}
+	return Default_FieldOptions_UnverifiedLazy
+}
+
+func (m *FieldOptions) GetDeprecated() bool {
+	if m != nil && m.Deprecated != nil {
+		return *m.Deprecated
+	}
+	return Default_FieldOptions_Deprecated
+}
+
+func (m *FieldOptions) GetWeak() bool {
+	if m != nil && m.Weak != nil {
+		return *m.Weak
+	}
+	return Default_FieldOptions_Weak
+}
+
+func (m *FieldOptions) GetDebugRedact() bool {
+	if m != nil && m.DebugRedact != nil {
+		return *m.DebugRedact
+	}
+	return Default_FieldOptions_DebugRedact
+}
+
+func (m *FieldOptions) GetRetention() FieldOptions_OptionRetention {
+	if m != nil && m.Retention != nil {
+		return *m.Retention
+	}
+	return FieldOptions_RETENTION_UNKNOWN
+}
+
+func (m *FieldOptions) GetTargets() []FieldOptions_OptionTargetType {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *FieldOptions) GetEditionDefaults() []*FieldOptions_EditionDefault {
+	if m != nil {
+		return m.EditionDefaults
+	}
+	return nil
+}
+
+func (m *FieldOptions) GetFeatures() *FeatureSet {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func (m *FieldOptions) GetFeatureSupport() *FieldOptions_FeatureSupport {
+	if m != nil {
+		return m.FeatureSupport
+	}
+	return nil
+}
+
+func (m *FieldOptions) GetUninterpretedOption() []*UninterpretedOption {
+	if m != nil {
+		return m.UninterpretedOption
+	}
+	return nil
+}
+
+type FieldOptions_EditionDefault struct {
+	Edition              *Edition `protobuf:"varint,3,opt,name=edition,enum=google.protobuf.Edition" json:"edition,omitempty"`
+	Value                *string  `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldOptions_EditionDefault) Reset()         { *m = FieldOptions_EditionDefault{} }
+func (m *FieldOptions_EditionDefault) String() string { return proto.CompactTextString(m) }
+func (*FieldOptions_EditionDefault) ProtoMessage()    {}
+func (*FieldOptions_EditionDefault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{12, 0}
+}
+func (m *FieldOptions_EditionDefault) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldOptions_EditionDefault.Unmarshal(m, b)
+}
+func (m *FieldOptions_EditionDefault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldOptions_EditionDefault.Marshal(b, m, deterministic)
+}
+func (m *FieldOptions_EditionDefault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldOptions_EditionDefault.Merge(m, src)
+}
+func (m *FieldOptions_EditionDefault) XXX_Size() int {
+	return xxx_messageInfo_FieldOptions_EditionDefault.Size(m)
+}
+func (m *FieldOptions_EditionDefault) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldOptions_EditionDefault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldOptions_EditionDefault proto.InternalMessageInfo
+
+func (m *FieldOptions_EditionDefault) GetEdition() Edition {
+	if m != nil && m.Edition != nil {
+		return *m.Edition
+	}
+	return Edition_EDITION_UNKNOWN
+}
+
+func (m *FieldOptions_EditionDefault) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+// Information about the support window of a feature.
+type FieldOptions_FeatureSupport struct {
+	// The edition that this feature was first available in.  In editions
+	// earlier than this one, the default assigned to EDITION_LEGACY will be
+	// used, and proto files will not be able to override it.
+	EditionIntroduced *Edition `protobuf:"varint,1,opt,name=edition_introduced,json=editionIntroduced,enum=google.protobuf.Edition" json:"edition_introduced,omitempty"`
+	// The edition this feature becomes deprecated in.  Using this after this
+	// edition may trigger warnings.
+	EditionDeprecated *Edition `protobuf:"varint,2,opt,name=edition_deprecated,json=editionDeprecated,enum=google.protobuf.Edition" json:"edition_deprecated,omitempty"`
+	// The deprecation warning text if this feature is used after the edition it
+	// was marked deprecated in.
+	DeprecationWarning *string `protobuf:"bytes,3,opt,name=deprecation_warning,json=deprecationWarning" json:"deprecation_warning,omitempty"`
+	// The edition this feature is no longer available in.  In editions after
+	// this one, the last default assigned will be used, and proto files will
+	// not be able to override it.
+	EditionRemoved       *Edition `protobuf:"varint,4,opt,name=edition_removed,json=editionRemoved,enum=google.protobuf.Edition" json:"edition_removed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldOptions_FeatureSupport) Reset()         { *m = FieldOptions_FeatureSupport{} }
+func (m *FieldOptions_FeatureSupport) String() string { return proto.CompactTextString(m) }
+func (*FieldOptions_FeatureSupport) ProtoMessage()    {}
+func (*FieldOptions_FeatureSupport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{12, 1}
+}
+func (m *FieldOptions_FeatureSupport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldOptions_FeatureSupport.Unmarshal(m, b)
+}
+func (m *FieldOptions_FeatureSupport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldOptions_FeatureSupport.Marshal(b, m, deterministic)
+}
+func (m *FieldOptions_FeatureSupport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldOptions_FeatureSupport.Merge(m, src)
+}
+func (m *FieldOptions_FeatureSupport) XXX_Size() int {
+	return xxx_messageInfo_FieldOptions_FeatureSupport.Size(m)
+}
+func (m *FieldOptions_FeatureSupport) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldOptions_FeatureSupport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldOptions_FeatureSupport proto.InternalMessageInfo
+
+func (m *FieldOptions_FeatureSupport) GetEditionIntroduced() Edition {
+	if m != nil && m.EditionIntroduced != nil {
+		return *m.EditionIntroduced
+	}
+	return Edition_EDITION_UNKNOWN
+}
+
+func (m *FieldOptions_FeatureSupport) GetEditionDeprecated() Edition {
+	if m != nil && m.EditionDeprecated != nil {
+		return *m.EditionDeprecated
+	}
+	return Edition_EDITION_UNKNOWN
+}
+
+func (m *FieldOptions_FeatureSupport) GetDeprecationWarning() string {
+	if m != nil && m.DeprecationWarning != nil {
+		return *m.DeprecationWarning
+	}
+	return ""
+}
+
+func (m *FieldOptions_FeatureSupport) GetEditionRemoved() Edition {
+	if m != nil && m.EditionRemoved != nil {
+		return *m.EditionRemoved
+	}
+	return Edition_EDITION_UNKNOWN
+}
+
+type OneofOptions struct {
+	// Any features defined in the specific edition.
+	Features *FeatureSet `protobuf:"bytes,1,opt,name=features" json:"features,omitempty"`
+	// The parser stores options it doesn't recognize here. See above.
+	UninterpretedOption          []*UninterpretedOption `protobuf:"bytes,999,rep,name=uninterpreted_option,json=uninterpretedOption" json:"uninterpreted_option,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}               `json:"-"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
+}
+
+func (m *OneofOptions) Reset()         { *m = OneofOptions{} }
+func (m *OneofOptions) String() string { return proto.CompactTextString(m) }
+func (*OneofOptions) ProtoMessage()    {}
+func (*OneofOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{13}
 }
 
-func (m *FieldOptions) GetExperimentalMapKey() string {
-	if m != nil && m.ExperimentalMapKey != nil {
-		return *m.ExperimentalMapKey
-	}
-	return ""
+var extRange_OneofOptions = []proto.ExtensionRange{
+	{Start: 1000, End: 536870911},
 }
 
-func (m *FieldOptions) GetWeak() bool {
-	if m != nil && m.Weak != nil {
-		return *m.Weak
+func (*OneofOptions) ExtensionRangeArray() []proto.ExtensionRange {
+	return extRange_OneofOptions
+}
+
+func (m *OneofOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OneofOptions.Unmarshal(m, b)
+}
+func (m *OneofOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OneofOptions.Marshal(b, m, deterministic)
+}
+func (m *OneofOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OneofOptions.Merge(m, src)
+}
+func (m *OneofOptions) XXX_Size() int {
+	return xxx_messageInfo_OneofOptions.Size(m)
+}
+func (m *OneofOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_OneofOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OneofOptions proto.InternalMessageInfo
+
+func (m *OneofOptions) GetFeatures() *FeatureSet {
+	if m != nil {
+		return m.Features
 	}
-	return Default_FieldOptions_Weak
+	return nil
 }
 
-func (m *FieldOptions) GetUninterpretedOption() []*UninterpretedOption {
+func (m *OneofOptions) GetUninterpretedOption() []*UninterpretedOption {
 	if m != nil {
 		return m.UninterpretedOption
 	}
@@ -1120,36 +2846,62 @@ func (m *FieldOptions) GetUninterpretedOption() []*UninterpretedOption {
 type EnumOptions struct {
 	// Set this option to true to allow mapping different tag names to the same
 	// value.
-	AllowAlias *bool `protobuf:"varint,2,opt,name=allow_alias" json:"allow_alias,omitempty"`
+	AllowAlias *bool `protobuf:"varint,2,opt,name=allow_alias,json=allowAlias" json:"allow_alias,omitempty"`
 	// Is this enum deprecated?
 	// Depending on the target platform, this can emit Deprecated annotations
 	// for the enum, or it will be completely ignored; in the very least, this
 	// is a formalization for deprecating enums.
 	Deprecated *bool `protobuf:"varint,3,opt,name=deprecated,def=0" json:"deprecated,omitempty"`
+	// Enable the legacy handling of JSON field name conflicts.  This lowercases
+	// and strips underscored from the fields before comparison in proto3 only.
+	// The new behavior takes `json_name` into account and applies to proto2 as
+	// well.
+	// TODO Remove this legacy behavior once downstream teams have
+	// had time to migrate.
+	DeprecatedLegacyJsonFieldConflicts *bool `protobuf:"varint,6,opt,name=deprecated_legacy_json_field_conflicts,json=deprecatedLegacyJsonFieldConflicts" json:"deprecated_legacy_json_field_conflicts,omitempty"` // Deprecated: Do not use.
+	// Any features defined in the specific edition.
+	Features *FeatureSet `protobuf:"bytes,7,opt,name=features" json:"features,omitempty"`
 	// The parser stores options it doesn't recognize here. See above.
-	UninterpretedOption []*UninterpretedOption    `protobuf:"bytes,999,rep,name=uninterpreted_option" json:"uninterpreted_option,omitempty"`
-	XXX_extensions      map[int32]proto.Extension `json:"-"`
-	XXX_unrecognized    []byte                    `json:"-"`
+	UninterpretedOption          []*UninterpretedOption `protobuf:"bytes,999,rep,name=uninterpreted_option,json=uninterpretedOption" json:"uninterpreted_option,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}               `json:"-"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
 }
 
 func (m *EnumOptions) Reset()         { *m = EnumOptions{} }
 func (m *EnumOptions) String() string { return proto.CompactTextString(m) }
 func (*EnumOptions) ProtoMessage()    {}
+func (*EnumOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{14}
+}
 
 var extRange_EnumOptions = []proto.ExtensionRange{
-	{1000, 536870911},
+	{Start: 1000, End: 536870911},
 }
 
 func (*EnumOptions) ExtensionRangeArray() []proto.ExtensionRange {
 	return extRange_EnumOptions
 }
-func (m *EnumOptions) ExtensionMap() map[int32]proto.Extension {
-	if m.XXX_extensions == nil {
-		m.XXX_extensions = make(map[int32]proto.Extension)
-	}
-	return m.XXX_extensions
+
+func (m *EnumOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnumOptions.Unmarshal(m, b)
+}
+func (m *EnumOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnumOptions.Marshal(b, m, deterministic)
+}
+func (m *EnumOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnumOptions.Merge(m, src)
+}
+func (m *EnumOptions) XXX_Size() int {
+	return xxx_messageInfo_EnumOptions.Size(m)
+}
+func (m *EnumOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnumOptions.DiscardUnknown(m)
 }
 
+var xxx_messageInfo_EnumOptions proto.InternalMessageInfo
+
 const Default_EnumOptions_Deprecated bool = false
 
 func (m *EnumOptions) GetAllowAlias() bool {
@@ -1166,6 +2918,21 @@ func (m *EnumOptions) GetDeprecated() bool {
 	return Default_EnumOptions_Deprecated
 }
 
+// Deprecated: Do not use.
+func (m *EnumOptions) GetDeprecatedLegacyJsonFieldConflicts() bool {
+	if m != nil && m.DeprecatedLegacyJsonFieldConflicts != nil {
+		return *m.DeprecatedLegacyJsonFieldConflicts
+	}
+	return false
+}
+
+func (m *EnumOptions) GetFeatures() *FeatureSet {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
 func (m *EnumOptions) GetUninterpretedOption() []*UninterpretedOption {
 	if m != nil {
 		return m.UninterpretedOption
@@ -1179,31 +2946,57 @@ type EnumValueOptions struct {
 	// for the enum value, or it will be completely ignored; in the very least,
 	// this is a formalization for deprecating enum values.
 	Deprecated *bool `protobuf:"varint,1,opt,name=deprecated,def=0" json:"deprecated,omitempty"`
+	// Any features defined in the specific edition.
+	Features *FeatureSet `protobuf:"bytes,2,opt,name=features" json:"features,omitempty"`
+	// Indicate that fields annotated with this enum value should not be printed
+	// out when using debug formats, e.g. when the field contains sensitive
+	// credentials.
+	DebugRedact *bool `protobuf:"varint,3,opt,name=debug_redact,json=debugRedact,def=0" json:"debug_redact,omitempty"`
+	// Information about the support window of a feature value.
+	FeatureSupport *FieldOptions_FeatureSupport `protobuf:"bytes,4,opt,name=feature_support,json=featureSupport" json:"feature_support,omitempty"`
 	// The parser stores options it doesn't recognize here. See above.
-	UninterpretedOption []*UninterpretedOption    `protobuf:"bytes,999,rep,name=uninterpreted_option" json:"uninterpreted_option,omitempty"`
-	XXX_extensions      map[int32]proto.Extension `json:"-"`
-	XXX_unrecognized    []byte                    `json:"-"`
+	UninterpretedOption          []*UninterpretedOption `protobuf:"bytes,999,rep,name=uninterpreted_option,json=uninterpretedOption" json:"uninterpreted_option,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}               `json:"-"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
 }
 
 func (m *EnumValueOptions) Reset()         { *m = EnumValueOptions{} }
 func (m *EnumValueOptions) String() string { return proto.CompactTextString(m) }
 func (*EnumValueOptions) ProtoMessage()    {}
+func (*EnumValueOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{15}
+}
 
 var extRange_EnumValueOptions = []proto.ExtensionRange{
-	{1000, 536870911},
+	{Start: 1000, End: 536870911},
 }
 
 func (*EnumValueOptions) ExtensionRangeArray() []proto.ExtensionRange {
 	return extRange_EnumValueOptions
 }
-func (m *EnumValueOptions) ExtensionMap() map[int32]proto.Extension {
-	if m.XXX_extensions == nil {
-		m.XXX_extensions = make(map[int32]proto.Extension)
-	}
-	return m.XXX_extensions
+
+func (m *EnumValueOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnumValueOptions.Unmarshal(m, b)
+}
+func (m *EnumValueOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnumValueOptions.Marshal(b, m, deterministic)
 }
+func (m *EnumValueOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnumValueOptions.Merge(m, src)
+}
+func (m *EnumValueOptions) XXX_Size() int {
+	return xxx_messageInfo_EnumValueOptions.Size(m)
+}
+func (m *EnumValueOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnumValueOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnumValueOptions proto.InternalMessageInfo
 
 const Default_EnumValueOptions_Deprecated bool = false
+const Default_EnumValueOptions_DebugRedact bool = false
 
 func (m *EnumValueOptions) GetDeprecated() bool {
 	if m != nil && m.Deprecated != nil {
@@ -1212,6 +3005,27 @@ func (m *EnumValueOptions) GetDeprecated() bool {
 	return Default_EnumValueOptions_Deprecated
 }
 
+func (m *EnumValueOptions) GetFeatures() *FeatureSet {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func (m *EnumValueOptions) GetDebugRedact() bool {
+	if m != nil && m.DebugRedact != nil {
+		return *m.DebugRedact
+	}
+	return Default_EnumValueOptions_DebugRedact
+}
+
+func (m *EnumValueOptions) GetFeatureSupport() *FieldOptions_FeatureSupport {
+	if m != nil {
+		return m.FeatureSupport
+	}
+	return nil
+}
+
 func (m *EnumValueOptions) GetUninterpretedOption() []*UninterpretedOption {
 	if m != nil {
 		return m.UninterpretedOption
@@ -1220,37 +3034,63 @@ func (m *EnumValueOptions) GetUninterpretedOption() []*UninterpretedOption {
 }
 
 type ServiceOptions struct {
+	// Any features defined in the specific edition.
+	Features *FeatureSet `protobuf:"bytes,34,opt,name=features" json:"features,omitempty"`
 	// Is this service deprecated?
 	// Depending on the target platform, this can emit Deprecated annotations
 	// for the service, or it will be completely ignored; in the very least,
 	// this is a formalization for deprecating services.
 	Deprecated *bool `protobuf:"varint,33,opt,name=deprecated,def=0" json:"deprecated,omitempty"`
 	// The parser stores options it doesn't recognize here. See above.
-	UninterpretedOption []*UninterpretedOption    `protobuf:"bytes,999,rep,name=uninterpreted_option" json:"uninterpreted_option,omitempty"`
-	XXX_extensions      map[int32]proto.Extension `json:"-"`
-	XXX_unrecognized    []byte                    `json:"-"`
+	UninterpretedOption          []*UninterpretedOption `protobuf:"bytes,999,rep,name=uninterpreted_option,json=uninterpretedOption" json:"uninterpreted_option,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}               `json:"-"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
 }
 
 func (m *ServiceOptions) Reset()         { *m = ServiceOptions{} }
 func (m *ServiceOptions) String() string { return proto.CompactTextString(m) }
 func (*ServiceOptions) ProtoMessage()    {}
+func (*ServiceOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{16}
+}
 
 var extRange_ServiceOptions = []proto.ExtensionRange{
-	{1000, 536870911},
+	{Start: 1000, End: 536870911},
 }
 
 func (*ServiceOptions) ExtensionRangeArray() []proto.ExtensionRange {
 	return extRange_ServiceOptions
 }
-func (m *ServiceOptions) ExtensionMap() map[int32]proto.Extension {
-	if m.XXX_extensions == nil {
-		m.XXX_extensions = make(map[int32]proto.Extension)
-	}
-	return m.XXX_extensions
+
+func (m *ServiceOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceOptions.Unmarshal(m, b)
+}
+func (m *ServiceOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceOptions.Marshal(b, m, deterministic)
+}
+func (m *ServiceOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceOptions.Merge(m, src)
+}
+func (m *ServiceOptions) XXX_Size() int {
+	return xxx_messageInfo_ServiceOptions.Size(m)
+}
+func (m *ServiceOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceOptions.DiscardUnknown(m)
 }
 
+var xxx_messageInfo_ServiceOptions proto.InternalMessageInfo
+
 const Default_ServiceOptions_Deprecated bool = false
 
+func (m *ServiceOptions) GetFeatures() *FeatureSet {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
 func (m *ServiceOptions) GetDeprecated() bool {
 	if m != nil && m.Deprecated != nil {
 		return *m.Deprecated
@@ -1270,32 +3110,53 @@ type MethodOptions struct {
 	// Depending on the target platform, this can emit Deprecated annotations
 	// for the method, or it will be completely ignored; in the very least,
 	// this is a formalization for deprecating methods.
-	Deprecated *bool `protobuf:"varint,33,opt,name=deprecated,def=0" json:"deprecated,omitempty"`
+	Deprecated       *bool                           `protobuf:"varint,33,opt,name=deprecated,def=0" json:"deprecated,omitempty"`
+	IdempotencyLevel *MethodOptions_IdempotencyLevel `protobuf:"varint,34,opt,name=idempotency_level,json=idempotencyLevel,enum=google.protobuf.MethodOptions_IdempotencyLevel,def=0" json:"idempotency_level,omitempty"`
+	// Any features defined in the specific edition.
+	Features *FeatureSet `protobuf:"bytes,35,opt,name=features" json:"features,omitempty"`
 	// The parser stores options it doesn't recognize here. See above.
-	UninterpretedOption []*UninterpretedOption    `protobuf:"bytes,999,rep,name=uninterpreted_option" json:"uninterpreted_option,omitempty"`
-	XXX_extensions      map[int32]proto.Extension `json:"-"`
-	XXX_unrecognized    []byte                    `json:"-"`
+	UninterpretedOption          []*UninterpretedOption `protobuf:"bytes,999,rep,name=uninterpreted_option,json=uninterpretedOption" json:"uninterpreted_option,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}               `json:"-"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
 }
 
 func (m *MethodOptions) Reset()         { *m = MethodOptions{} }
 func (m *MethodOptions) String() string { return proto.CompactTextString(m) }
 func (*MethodOptions) ProtoMessage()    {}
+func (*MethodOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{17}
+}
 
 var extRange_MethodOptions = []proto.ExtensionRange{
-	{1000, 536870911},
+	{Start: 1000, End: 536870911},
 }
 
 func (*MethodOptions) ExtensionRangeArray() []proto.ExtensionRange {
 	return extRange_MethodOptions
 }
-func (m *MethodOptions) ExtensionMap() map[int32]proto.Extension {
-	if m.XXX_extensions == nil {
-		m.XXX_extensions = make(map[int32]proto.Extension)
-	}
-	return m.XXX_extensions
+
+func (m *MethodOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MethodOptions.Unmarshal(m, b)
+}
+func (m *MethodOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MethodOptions.Marshal(b, m, deterministic)
+}
+func (m *MethodOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MethodOptions.Merge(m, src)
+}
+func (m *MethodOptions) XXX_Size() int {
+	return xxx_messageInfo_MethodOptions.Size(m)
+}
+func (m *MethodOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MethodOptions.DiscardUnknown(m)
 }
 
+var xxx_messageInfo_MethodOptions proto.InternalMessageInfo
+
 const Default_MethodOptions_Deprecated bool = false
+const Default_MethodOptions_IdempotencyLevel MethodOptions_IdempotencyLevel = MethodOptions_IDEMPOTENCY_UNKNOWN
 
 func (m *MethodOptions) GetDeprecated() bool {
 	if m != nil && m.Deprecated != nil {
@@ -1304,6 +3165,20 @@ func (m *MethodOptions) GetDeprecated() bool {
 	return Default_MethodOptions_Deprecated
 }
 
+func (m *MethodOptions) GetIdempotencyLevel() MethodOptions_IdempotencyLevel {
+	if m != nil && m.IdempotencyLevel != nil {
+		return *m.IdempotencyLevel
+	}
+	return Default_MethodOptions_IdempotencyLevel
+}
+
+func (m *MethodOptions) GetFeatures() *FeatureSet {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
 func (m *MethodOptions) GetUninterpretedOption() []*UninterpretedOption {
 	if m != nil {
 		return m.UninterpretedOption
@@ -1321,18 +3196,40 @@ type UninterpretedOption struct {
 	Name []*UninterpretedOption_NamePart `protobuf:"bytes,2,rep,name=name" json:"name,omitempty"`
 	// The value of the uninterpreted option, in whatever type the tokenizer
 	// identified it as during parsing. Exactly one of these should be set.
-	IdentifierValue  *string  `protobuf:"bytes,3,opt,name=identifier_value" json:"identifier_value,omitempty"`
-	PositiveIntValue *uint64  `protobuf:"varint,4,opt,name=positive_int_value" json:"positive_int_value,omitempty"`
-	NegativeIntValue *int64   `protobuf:"varint,5,opt,name=negative_int_value" json:"negative_int_value,omitempty"`
-	DoubleValue      *float64 `protobuf:"fixed64,6,opt,name=double_value" json:"double_value,omitempty"`
-	StringValue      []byte   `protobuf:"bytes,7,opt,name=string_value" json:"string_value,omitempty"`
-	AggregateValue   *string  `protobuf:"bytes,8,opt,name=aggregate_value" json:"aggregate_value,omitempty"`
-	XXX_unrecognized []byte   `json:"-"`
+	IdentifierValue      *string  `protobuf:"bytes,3,opt,name=identifier_value,json=identifierValue" json:"identifier_value,omitempty"`
+	PositiveIntValue     *uint64  `protobuf:"varint,4,opt,name=positive_int_value,json=positiveIntValue" json:"positive_int_value,omitempty"`
+	NegativeIntValue     *int64   `protobuf:"varint,5,opt,name=negative_int_value,json=negativeIntValue" json:"negative_int_value,omitempty"`
+	DoubleValue          *float64 `protobuf:"fixed64,6,opt,name=double_value,json=doubleValue" json:"double_value,omitempty"`
+	StringValue          []byte   `protobuf:"bytes,7,opt,name=string_value,json=stringValue" json:"string_value,omitempty"`
+	AggregateValue       *string  `protobuf:"bytes,8,opt,name=aggregate_value,json=aggregateValue" json:"aggregate_value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UninterpretedOption) Reset()         { *m = UninterpretedOption{} }
 func (m *UninterpretedOption) String() string { return proto.CompactTextString(m) }
 func (*UninterpretedOption) ProtoMessage()    {}
+func (*UninterpretedOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{18}
+}
+func (m *UninterpretedOption) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UninterpretedOption.Unmarshal(m, b)
+}
+func (m *UninterpretedOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UninterpretedOption.Marshal(b, m, deterministic)
+}
+func (m *UninterpretedOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UninterpretedOption.Merge(m, src)
+}
+func (m *UninterpretedOption) XXX_Size() int {
+	return xxx_messageInfo_UninterpretedOption.Size(m)
+}
+func (m *UninterpretedOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_UninterpretedOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UninterpretedOption proto.InternalMessageInfo
 
 func (m *UninterpretedOption) GetName() []*UninterpretedOption_NamePart {
 	if m != nil {
@@ -1386,17 +3283,39 @@ func (m *UninterpretedOption) GetAggregateValue() string {
 // The name of the uninterpreted option.  Each string represents a segment in
 // a dot-separated name.  is_extension is true iff a segment represents an
 // extension (denoted with parentheses in options specs in .proto files).
-// E.g.,{ ["foo", false], ["bar.baz", true], ["qux", false] } represents
-// "foo.(bar.baz).qux".
+// E.g.,{ ["foo", false], ["bar.baz", true], ["moo", false] } represents
+// "foo.(bar.baz).moo".
 type UninterpretedOption_NamePart struct {
-	NamePart         *string `protobuf:"bytes,1,req,name=name_part" json:"name_part,omitempty"`
-	IsExtension      *bool   `protobuf:"varint,2,req,name=is_extension" json:"is_extension,omitempty"`
-	XXX_unrecognized []byte  `json:"-"`
+	NamePart             *string  `protobuf:"bytes,1,req,name=name_part,json=namePart" json:"name_part,omitempty"`
+	IsExtension          *bool    `protobuf:"varint,2,req,name=is_extension,json=isExtension" json:"is_extension,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UninterpretedOption_NamePart) Reset()         { *m = UninterpretedOption_NamePart{} }
 func (m *UninterpretedOption_NamePart) String() string { return proto.CompactTextString(m) }
 func (*UninterpretedOption_NamePart) ProtoMessage()    {}
+func (*UninterpretedOption_NamePart) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{18, 0}
+}
+func (m *UninterpretedOption_NamePart) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UninterpretedOption_NamePart.Unmarshal(m, b)
+}
+func (m *UninterpretedOption_NamePart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UninterpretedOption_NamePart.Marshal(b, m, deterministic)
+}
+func (m *UninterpretedOption_NamePart) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UninterpretedOption_NamePart.Merge(m, src)
+}
+func (m *UninterpretedOption_NamePart) XXX_Size() int {
+	return xxx_messageInfo_UninterpretedOption_NamePart.Size(m)
+}
+func (m *UninterpretedOption_NamePart) XXX_DiscardUnknown() {
+	xxx_messageInfo_UninterpretedOption_NamePart.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UninterpretedOption_NamePart proto.InternalMessageInfo
 
 func (m *UninterpretedOption_NamePart) GetNamePart() string {
 	if m != nil && m.NamePart != nil {
@@ -1412,6 +3331,228 @@ func (m *UninterpretedOption_NamePart) GetIsExtension() bool {
 	return false
 }
 
+// TODO Enums in C++ gencode (and potentially other languages) are
+// not well scoped.  This means that each of the feature enums below can clash
+// with each other.  The short names we've chosen maximize call-site
+// readability, but leave us very open to this scenario.  A future feature will
+// be designed and implemented to handle this, hopefully before we ever hit a
+// conflict here.
+type FeatureSet struct {
+	FieldPresence                *FeatureSet_FieldPresence         `protobuf:"varint,1,opt,name=field_presence,json=fieldPresence,enum=google.protobuf.FeatureSet_FieldPresence" json:"field_presence,omitempty"`
+	EnumType                     *FeatureSet_EnumType              `protobuf:"varint,2,opt,name=enum_type,json=enumType,enum=google.protobuf.FeatureSet_EnumType" json:"enum_type,omitempty"`
+	RepeatedFieldEncoding        *FeatureSet_RepeatedFieldEncoding `protobuf:"varint,3,opt,name=repeated_field_encoding,json=repeatedFieldEncoding,enum=google.protobuf.FeatureSet_RepeatedFieldEncoding" json:"repeated_field_encoding,omitempty"`
+	Utf8Validation               *FeatureSet_Utf8Validation        `protobuf:"varint,4,opt,name=utf8_validation,json=utf8Validation,enum=google.protobuf.FeatureSet_Utf8Validation" json:"utf8_validation,omitempty"`
+	MessageEncoding              *FeatureSet_MessageEncoding       `protobuf:"varint,5,opt,name=message_encoding,json=messageEncoding,enum=google.protobuf.FeatureSet_MessageEncoding" json:"message_encoding,omitempty"`
+	JsonFormat                   *FeatureSet_JsonFormat            `protobuf:"varint,6,opt,name=json_format,json=jsonFormat,enum=google.protobuf.FeatureSet_JsonFormat" json:"json_format,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                          `json:"-"`
+	proto.XXX_InternalExtensions `json:"-"`
+	XXX_unrecognized             []byte `json:"-"`
+	XXX_sizecache                int32  `json:"-"`
+}
+
+func (m *FeatureSet) Reset()         { *m = FeatureSet{} }
+func (m *FeatureSet) String() string { return proto.CompactTextString(m) }
+func (*FeatureSet) ProtoMessage()    {}
+func (*FeatureSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{19}
+}
+
+var extRange_FeatureSet = []proto.ExtensionRange{
+	{Start: 1000, End: 9994},
+	{Start: 9995, End: 9999},
+	{Start: 10000, End: 10000},
+}
+
+func (*FeatureSet) ExtensionRangeArray() []proto.ExtensionRange {
+	return extRange_FeatureSet
+}
+
+func (m *FeatureSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeatureSet.Unmarshal(m, b)
+}
+func (m *FeatureSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeatureSet.Marshal(b, m, deterministic)
+}
+func (m *FeatureSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureSet.Merge(m, src)
+}
+func (m *FeatureSet) XXX_Size() int {
+	return xxx_messageInfo_FeatureSet.Size(m)
+}
+func (m *FeatureSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureSet proto.InternalMessageInfo
+
+func (m *FeatureSet) GetFieldPresence() FeatureSet_FieldPresence {
+	if m != nil && m.FieldPresence != nil {
+		return *m.FieldPresence
+	}
+	return FeatureSet_FIELD_PRESENCE_UNKNOWN
+}
+
+func (m *FeatureSet) GetEnumType() FeatureSet_EnumType {
+	if m != nil && m.EnumType != nil {
+		return *m.EnumType
+	}
+	return FeatureSet_ENUM_TYPE_UNKNOWN
+}
+
+func (m *FeatureSet) GetRepeatedFieldEncoding() FeatureSet_RepeatedFieldEncoding {
+	if m != nil && m.RepeatedFieldEncoding != nil {
+		return *m.RepeatedFieldEncoding
+	}
+	return FeatureSet_REPEATED_FIELD_ENCODING_UNKNOWN
+}
+
+func (m *FeatureSet) GetUtf8Validation() FeatureSet_Utf8Validation {
+	if m != nil && m.Utf8Validation != nil {
+		return *m.Utf8Validation
+	}
+	return FeatureSet_UTF8_VALIDATION_UNKNOWN
+}
+
+func (m *FeatureSet) GetMessageEncoding() FeatureSet_MessageEncoding {
+	if m != nil && m.MessageEncoding != nil {
+		return *m.MessageEncoding
+	}
+	return FeatureSet_MESSAGE_ENCODING_UNKNOWN
+}
+
+func (m *FeatureSet) GetJsonFormat() FeatureSet_JsonFormat {
+	if m != nil && m.JsonFormat != nil {
+		return *m.JsonFormat
+	}
+	return FeatureSet_JSON_FORMAT_UNKNOWN
+}
+
+// A compiled specification for the defaults of a set of features.  These
+// messages are generated from FeatureSet extensions and can be used to seed
+// feature resolution. The resolution with this object becomes a simple search
+// for the closest matching edition, followed by proto merges.
+type FeatureSetDefaults struct {
+	Defaults []*FeatureSetDefaults_FeatureSetEditionDefault `protobuf:"bytes,1,rep,name=defaults" json:"defaults,omitempty"`
+	// The minimum supported edition (inclusive) when this was constructed.
+	// Editions before this will not have defaults.
+	MinimumEdition *Edition `protobuf:"varint,4,opt,name=minimum_edition,json=minimumEdition,enum=google.protobuf.Edition" json:"minimum_edition,omitempty"`
+	// The maximum known edition (inclusive) when this was constructed. Editions
+	// after this will not have reliable defaults.
+	MaximumEdition       *Edition `protobuf:"varint,5,opt,name=maximum_edition,json=maximumEdition,enum=google.protobuf.Edition" json:"maximum_edition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeatureSetDefaults) Reset()         { *m = FeatureSetDefaults{} }
+func (m *FeatureSetDefaults) String() string { return proto.CompactTextString(m) }
+func (*FeatureSetDefaults) ProtoMessage()    {}
+func (*FeatureSetDefaults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{20}
+}
+func (m *FeatureSetDefaults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeatureSetDefaults.Unmarshal(m, b)
+}
+func (m *FeatureSetDefaults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeatureSetDefaults.Marshal(b, m, deterministic)
+}
+func (m *FeatureSetDefaults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureSetDefaults.Merge(m, src)
+}
+func (m *FeatureSetDefaults) XXX_Size() int {
+	return xxx_messageInfo_FeatureSetDefaults.Size(m)
+}
+func (m *FeatureSetDefaults) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureSetDefaults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureSetDefaults proto.InternalMessageInfo
+
+func (m *FeatureSetDefaults) GetDefaults() []*FeatureSetDefaults_FeatureSetEditionDefault {
+	if m != nil {
+		return m.Defaults
+	}
+	return nil
+}
+
+func (m *FeatureSetDefaults) GetMinimumEdition() Edition {
+	if m != nil && m.MinimumEdition != nil {
+		return *m.MinimumEdition
+	}
+	return Edition_EDITION_UNKNOWN
+}
+
+func (m *FeatureSetDefaults) GetMaximumEdition() Edition {
+	if m != nil && m.MaximumEdition != nil {
+		return *m.MaximumEdition
+	}
+	return Edition_EDITION_UNKNOWN
+}
+
+// A map from every known edition with a unique set of defaults to its
+// defaults. Not all editions may be contained here.  For a given edition,
+// the defaults at the closest matching edition ordered at or before it should
+// be used.  This field must be in strict ascending order by edition.
+type FeatureSetDefaults_FeatureSetEditionDefault struct {
+	Edition *Edition `protobuf:"varint,3,opt,name=edition,enum=google.protobuf.Edition" json:"edition,omitempty"`
+	// Defaults of features that can be overridden in this edition.
+	OverridableFeatures *FeatureSet `protobuf:"bytes,4,opt,name=overridable_features,json=overridableFeatures" json:"overridable_features,omitempty"`
+	// Defaults of features that can't be overridden in this edition.
+	FixedFeatures        *FeatureSet `protobuf:"bytes,5,opt,name=fixed_features,json=fixedFeatures" json:"fixed_features,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *FeatureSetDefaults_FeatureSetEditionDefault) Reset() {
+	*m = FeatureSetDefaults_FeatureSetEditionDefault{}
+}
+func (m *FeatureSetDefaults_FeatureSetEditionDefault) String() string {
+	return proto.CompactTextString(m)
+}
+func (*FeatureSetDefaults_FeatureSetEditionDefault) ProtoMessage() {}
+func (*FeatureSetDefaults_FeatureSetEditionDefault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{20, 0}
+}
+func (m *FeatureSetDefaults_FeatureSetEditionDefault) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeatureSetDefaults_FeatureSetEditionDefault.Unmarshal(m, b)
+}
+func (m *FeatureSetDefaults_FeatureSetEditionDefault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeatureSetDefaults_FeatureSetEditionDefault.Marshal(b, m, deterministic)
+}
+func (m *FeatureSetDefaults_FeatureSetEditionDefault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureSetDefaults_FeatureSetEditionDefault.Merge(m, src)
+}
+func (m *FeatureSetDefaults_FeatureSetEditionDefault) XXX_Size() int {
+	return xxx_messageInfo_FeatureSetDefaults_FeatureSetEditionDefault.Size(m)
+}
+func (m *FeatureSetDefaults_FeatureSetEditionDefault) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureSetDefaults_FeatureSetEditionDefault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureSetDefaults_FeatureSetEditionDefault proto.InternalMessageInfo
+
+func (m *FeatureSetDefaults_FeatureSetEditionDefault) GetEdition() Edition {
+	if m != nil && m.Edition != nil {
+		return *m.Edition
+	}
+	return Edition_EDITION_UNKNOWN
+}
+
+func (m *FeatureSetDefaults_FeatureSetEditionDefault) GetOverridableFeatures() *FeatureSet {
+	if m != nil {
+		return m.OverridableFeatures
+	}
+	return nil
+}
+
+func (m *FeatureSetDefaults_FeatureSetEditionDefault) GetFixedFeatures() *FeatureSet {
+	if m != nil {
+		return m.FixedFeatures
+	}
+	return nil
+}
+
 // Encapsulates information about the original source file from which a
 // FileDescriptorProto was generated.
 type SourceCodeInfo struct {
@@ -1452,19 +3593,41 @@ type SourceCodeInfo struct {
 	//   beginning of the "extend" block and is shared by all extensions within
 	//   the block.
 	// - Just because a location's span is a subset of some other location's span
-	//   does not mean that it is a descendent.  For example, a "group" defines
+	//   does not mean that it is a descendant.  For example, a "group" defines
 	//   both a type and a field in a single declaration.  Thus, the locations
 	//   corresponding to the type and field and their components will overlap.
 	// - Code which tries to interpret locations should probably be designed to
 	//   ignore those that it doesn't understand, as more types of locations could
 	//   be recorded in the future.
-	Location         []*SourceCodeInfo_Location `protobuf:"bytes,1,rep,name=location" json:"location,omitempty"`
-	XXX_unrecognized []byte                     `json:"-"`
+	Location             []*SourceCodeInfo_Location `protobuf:"bytes,1,rep,name=location" json:"location,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
 func (m *SourceCodeInfo) Reset()         { *m = SourceCodeInfo{} }
 func (m *SourceCodeInfo) String() string { return proto.CompactTextString(m) }
 func (*SourceCodeInfo) ProtoMessage()    {}
+func (*SourceCodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{21}
+}
+func (m *SourceCodeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SourceCodeInfo.Unmarshal(m, b)
+}
+func (m *SourceCodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SourceCodeInfo.Marshal(b, m, deterministic)
+}
+func (m *SourceCodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceCodeInfo.Merge(m, src)
+}
+func (m *SourceCodeInfo) XXX_Size() int {
+	return xxx_messageInfo_SourceCodeInfo.Size(m)
+}
+func (m *SourceCodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceCodeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceCodeInfo proto.InternalMessageInfo
 
 func (m *SourceCodeInfo) GetLocation() []*SourceCodeInfo_Location {
 	if m != nil {
@@ -1478,8 +3641,8 @@ type SourceCodeInfo_Location struct {
 	// location.
 	//
 	// Each element is a field number or an index.  They form a path from
-	// the root FileDescriptorProto to the place where the definition.  For
-	// example, this path:
+	// the root FileDescriptorProto to the place where the definition appears.
+	// For example, this path:
 	//   [ 4, 3, 2, 7, 1 ]
 	// refers to:
 	//   file.message_type(3)  // 4, 3
@@ -1511,6 +3674,11 @@ type SourceCodeInfo_Location struct {
 	// A series of line comments appearing on consecutive lines, with no other
 	// tokens appearing on those lines, will be treated as a single comment.
 	//
+	// leading_detached_comments will keep paragraphs of comments that appear
+	// before (but not connected to) the current element. Each paragraph,
+	// separated by empty lines, will be one comment element in the repeated
+	// field.
+	//
 	// Only the comment content is provided; comment markers (e.g. //) are
 	// stripped out.  For block comments, leading whitespace and an asterisk
 	// will be stripped from the beginning of each line other than the first.
@@ -1526,10 +3694,16 @@ type SourceCodeInfo_Location struct {
 	//   // Comment attached to baz.
 	//   // Another line attached to baz.
 	//
-	//   // Comment attached to qux.
+	//   // Comment attached to moo.
 	//   //
-	//   // Another line attached to qux.
-	//   optional double qux = 4;
+	//   // Another line attached to moo.
+	//   optional double moo = 4;
+	//
+	//   // Detached comment for corge. This is not leading or trailing comments
+	//   // to moo or corge because there are blank lines separating it from
+	//   // both.
+	//
+	//   // Detached comment for corge paragraph 2.
 	//
 	//   optional string corge = 5;
 	//   /* Block comment attached
@@ -1538,14 +3712,39 @@ type SourceCodeInfo_Location struct {
 	//   /* Block comment attached to
 	//    * grault. */
 	//   optional int32 grault = 6;
-	LeadingComments  *string `protobuf:"bytes,3,opt,name=leading_comments" json:"leading_comments,omitempty"`
-	TrailingComments *string `protobuf:"bytes,4,opt,name=trailing_comments" json:"trailing_comments,omitempty"`
-	XXX_unrecognized []byte  `json:"-"`
+	//
+	//   // ignored detached comments.
+	LeadingComments         *string  `protobuf:"bytes,3,opt,name=leading_comments,json=leadingComments" json:"leading_comments,omitempty"`
+	TrailingComments        *string  `protobuf:"bytes,4,opt,name=trailing_comments,json=trailingComments" json:"trailing_comments,omitempty"`
+	LeadingDetachedComments []string `protobuf:"bytes,6,rep,name=leading_detached_comments,json=leadingDetachedComments" json:"leading_detached_comments,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
 }
 
 func (m *SourceCodeInfo_Location) Reset()         { *m = SourceCodeInfo_Location{} }
 func (m *SourceCodeInfo_Location) String() string { return proto.CompactTextString(m) }
 func (*SourceCodeInfo_Location) ProtoMessage()    {}
+func (*SourceCodeInfo_Location) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{21, 0}
+}
+func (m *SourceCodeInfo_Location) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SourceCodeInfo_Location.Unmarshal(m, b)
+}
+func (m *SourceCodeInfo_Location) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SourceCodeInfo_Location.Marshal(b, m, deterministic)
+}
+func (m *SourceCodeInfo_Location) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceCodeInfo_Location.Merge(m, src)
+}
+func (m *SourceCodeInfo_Location) XXX_Size() int {
+	return xxx_messageInfo_SourceCodeInfo_Location.Size(m)
+}
+func (m *SourceCodeInfo_Location) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceCodeInfo_Location.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceCodeInfo_Location proto.InternalMessageInfo
 
 func (m *SourceCodeInfo_Location) GetPath() []int32 {
 	if m != nil {
@@ -1575,9 +3774,452 @@ func (m *SourceCodeInfo_Location) GetTrailingComments() string {
 	return ""
 }
 
+func (m *SourceCodeInfo_Location) GetLeadingDetachedComments() []string {
+	if m != nil {
+		return m.LeadingDetachedComments
+	}
+	return nil
+}
+
+// Describes the relationship between generated code and its original source
+// file. A GeneratedCodeInfo message is associated with only one generated
+// source file, but may contain references to different source .proto files.
+type GeneratedCodeInfo struct {
+	// An Annotation connects some span of text in generated code to an element
+	// of its generating .proto file.
+	Annotation           []*GeneratedCodeInfo_Annotation `protobuf:"bytes,1,rep,name=annotation" json:"annotation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *GeneratedCodeInfo) Reset()         { *m = GeneratedCodeInfo{} }
+func (m *GeneratedCodeInfo) String() string { return proto.CompactTextString(m) }
+func (*GeneratedCodeInfo) ProtoMessage()    {}
+func (*GeneratedCodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{22}
+}
+func (m *GeneratedCodeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GeneratedCodeInfo.Unmarshal(m, b)
+}
+func (m *GeneratedCodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GeneratedCodeInfo.Marshal(b, m, deterministic)
+}
+func (m *GeneratedCodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeneratedCodeInfo.Merge(m, src)
+}
+func (m *GeneratedCodeInfo) XXX_Size() int {
+	return xxx_messageInfo_GeneratedCodeInfo.Size(m)
+}
+func (m *GeneratedCodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeneratedCodeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeneratedCodeInfo proto.InternalMessageInfo
+
+func (m *GeneratedCodeInfo) GetAnnotation() []*GeneratedCodeInfo_Annotation {
+	if m != nil {
+		return m.Annotation
+	}
+	return nil
+}
+
+type GeneratedCodeInfo_Annotation struct {
+	// Identifies the element in the original source .proto file. This field
+	// is formatted the same as SourceCodeInfo.Location.path.
+	Path []int32 `protobuf:"varint,1,rep,packed,name=path" json:"path,omitempty"`
+	// Identifies the filesystem path to the original source .proto.
+	SourceFile *string `protobuf:"bytes,2,opt,name=source_file,json=sourceFile" json:"source_file,omitempty"`
+	// Identifies the starting offset in bytes in the generated code
+	// that relates to the identified object.
+	Begin *int32 `protobuf:"varint,3,opt,name=begin" json:"begin,omitempty"`
+	// Identifies the ending offset in bytes in the generated code that
+	// relates to the identified object. The end offset should be one past
+	// the last relevant byte (so the length of the text = end - begin).
+	End                  *int32                                 `protobuf:"varint,4,opt,name=end" json:"end,omitempty"`
+	Semantic             *GeneratedCodeInfo_Annotation_Semantic `protobuf:"varint,5,opt,name=semantic,enum=google.protobuf.GeneratedCodeInfo_Annotation_Semantic" json:"semantic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
+}
+
+func (m *GeneratedCodeInfo_Annotation) Reset()         { *m = GeneratedCodeInfo_Annotation{} }
+func (m *GeneratedCodeInfo_Annotation) String() string { return proto.CompactTextString(m) }
+func (*GeneratedCodeInfo_Annotation) ProtoMessage()    {}
+func (*GeneratedCodeInfo_Annotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5baabe45344a177, []int{22, 0}
+}
+func (m *GeneratedCodeInfo_Annotation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GeneratedCodeInfo_Annotation.Unmarshal(m, b)
+}
+func (m *GeneratedCodeInfo_Annotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GeneratedCodeInfo_Annotation.Marshal(b, m, deterministic)
+}
+func (m *GeneratedCodeInfo_Annotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeneratedCodeInfo_Annotation.Merge(m, src)
+}
+func (m *GeneratedCodeInfo_Annotation) XXX_Size() int {
+	return xxx_messageInfo_GeneratedCodeInfo_Annotation.Size(m)
+}
+func (m *GeneratedCodeInfo_Annotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeneratedCodeInfo_Annotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeneratedCodeInfo_Annotation proto.InternalMessageInfo
+
+func (m *GeneratedCodeInfo_Annotation) GetPath() []int32 {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *GeneratedCodeInfo_Annotation) GetSourceFile() string {
+	if m != nil && m.SourceFile != nil {
+		return *m.SourceFile
+	}
+	return ""
+}
+
+func (m *GeneratedCodeInfo_Annotation) GetBegin() int32 {
+	if m != nil && m.Begin != nil {
+		return *m.Begin
+	}
+	return 0
+}
+
+func (m *GeneratedCodeInfo_Annotation) GetEnd() int32 {
+	if m != nil && m.End != nil {
+		return *m.End
+	}
+	return 0
+}
+
+func (m *GeneratedCodeInfo_Annotation) GetSemantic() GeneratedCodeInfo_Annotation_Semantic {
+	if m != nil && m.Semantic != nil {
+		return *m.Semantic
+	}
+	return GeneratedCodeInfo_Annotation_NONE
+}
+
 func init() {
+	proto.RegisterEnum("google.protobuf.Edition", Edition_name, Edition_value)
+	proto.RegisterEnum("google.protobuf.ExtensionRangeOptions_VerificationState", ExtensionRangeOptions_VerificationState_name, ExtensionRangeOptions_VerificationState_value)
 	proto.RegisterEnum("google.protobuf.FieldDescriptorProto_Type", FieldDescriptorProto_Type_name, FieldDescriptorProto_Type_value)
 	proto.RegisterEnum("google.protobuf.FieldDescriptorProto_Label", FieldDescriptorProto_Label_name, FieldDescriptorProto_Label_value)
 	proto.RegisterEnum("google.protobuf.FileOptions_OptimizeMode", FileOptions_OptimizeMode_name, FileOptions_OptimizeMode_value)
 	proto.RegisterEnum("google.protobuf.FieldOptions_CType", FieldOptions_CType_name, FieldOptions_CType_value)
+	proto.RegisterEnum("google.protobuf.FieldOptions_JSType", FieldOptions_JSType_name, FieldOptions_JSType_value)
+	proto.RegisterEnum("google.protobuf.FieldOptions_OptionRetention", FieldOptions_OptionRetention_name, FieldOptions_OptionRetention_value)
+	proto.RegisterEnum("google.protobuf.FieldOptions_OptionTargetType", FieldOptions_OptionTargetType_name, FieldOptions_OptionTargetType_value)
+	proto.RegisterEnum("google.protobuf.MethodOptions_IdempotencyLevel", MethodOptions_IdempotencyLevel_name, MethodOptions_IdempotencyLevel_value)
+	proto.RegisterEnum("google.protobuf.FeatureSet_FieldPresence", FeatureSet_FieldPresence_name, FeatureSet_FieldPresence_value)
+	proto.RegisterEnum("google.protobuf.FeatureSet_EnumType", FeatureSet_EnumType_name, FeatureSet_EnumType_value)
+	proto.RegisterEnum("google.protobuf.FeatureSet_RepeatedFieldEncoding", FeatureSet_RepeatedFieldEncoding_name, FeatureSet_RepeatedFieldEncoding_value)
+	proto.RegisterEnum("google.protobuf.FeatureSet_Utf8Validation", FeatureSet_Utf8Validation_name, FeatureSet_Utf8Validation_value)
+	proto.RegisterEnum("google.protobuf.FeatureSet_MessageEncoding", FeatureSet_MessageEncoding_name, FeatureSet_MessageEncoding_value)
+	proto.RegisterEnum("google.protobuf.FeatureSet_JsonFormat", FeatureSet_JsonFormat_name, FeatureSet_JsonFormat_value)
+	proto.RegisterEnum("google.protobuf.GeneratedCodeInfo_Annotation_Semantic", GeneratedCodeInfo_Annotation_Semantic_name, GeneratedCodeInfo_Annotation_Semantic_value)
+	proto.RegisterType((*FileDescriptorSet)(nil), "google.protobuf.FileDescriptorSet")
+	proto.RegisterType((*FileDescriptorProto)(nil), "google.protobuf.FileDescriptorProto")
+	proto.RegisterType((*DescriptorProto)(nil), "google.protobuf.DescriptorProto")
+	proto.RegisterType((*DescriptorProto_ExtensionRange)(nil), "google.protobuf.DescriptorProto.ExtensionRange")
+	proto.RegisterType((*DescriptorProto_ReservedRange)(nil), "google.protobuf.DescriptorProto.ReservedRange")
+	proto.RegisterType((*ExtensionRangeOptions)(nil), "google.protobuf.ExtensionRangeOptions")
+	proto.RegisterType((*ExtensionRangeOptions_Declaration)(nil), "google.protobuf.ExtensionRangeOptions.Declaration")
+	proto.RegisterType((*FieldDescriptorProto)(nil), "google.protobuf.FieldDescriptorProto")
+	proto.RegisterType((*OneofDescriptorProto)(nil), "google.protobuf.OneofDescriptorProto")
+	proto.RegisterType((*EnumDescriptorProto)(nil), "google.protobuf.EnumDescriptorProto")
+	proto.RegisterType((*EnumDescriptorProto_EnumReservedRange)(nil), "google.protobuf.EnumDescriptorProto.EnumReservedRange")
+	proto.RegisterType((*EnumValueDescriptorProto)(nil), "google.protobuf.EnumValueDescriptorProto")
+	proto.RegisterType((*ServiceDescriptorProto)(nil), "google.protobuf.ServiceDescriptorProto")
+	proto.RegisterType((*MethodDescriptorProto)(nil), "google.protobuf.MethodDescriptorProto")
+	proto.RegisterType((*FileOptions)(nil), "google.protobuf.FileOptions")
+	proto.RegisterType((*MessageOptions)(nil), "google.protobuf.MessageOptions")
+	proto.RegisterType((*FieldOptions)(nil), "google.protobuf.FieldOptions")
+	proto.RegisterType((*FieldOptions_EditionDefault)(nil), "google.protobuf.FieldOptions.EditionDefault")
+	proto.RegisterType((*FieldOptions_FeatureSupport)(nil), "google.protobuf.FieldOptions.FeatureSupport")
+	proto.RegisterType((*OneofOptions)(nil), "google.protobuf.OneofOptions")
+	proto.RegisterType((*EnumOptions)(nil), "google.protobuf.EnumOptions")
+	proto.RegisterType((*EnumValueOptions)(nil), "google.protobuf.EnumValueOptions")
+	proto.RegisterType((*ServiceOptions)(nil), "google.protobuf.ServiceOptions")
+	proto.RegisterType((*MethodOptions)(nil), "google.protobuf.MethodOptions")
+	proto.RegisterType((*UninterpretedOption)(nil), "google.protobuf.UninterpretedOption")
+	proto.RegisterType((*UninterpretedOption_NamePart)(nil), "google.protobuf.UninterpretedOption.NamePart")
+	proto.RegisterType((*FeatureSet)(nil), "google.protobuf.FeatureSet")
+	proto.RegisterType((*FeatureSetDefaults)(nil), "google.protobuf.FeatureSetDefaults")
+	proto.RegisterType((*FeatureSetDefaults_FeatureSetEditionDefault)(nil), "google.protobuf.FeatureSetDefaults.FeatureSetEditionDefault")
+	proto.RegisterType((*SourceCodeInfo)(nil), "google.protobuf.SourceCodeInfo")
+	proto.RegisterType((*SourceCodeInfo_Location)(nil), "google.protobuf.SourceCodeInfo.Location")
+	proto.RegisterType((*GeneratedCodeInfo)(nil), "google.protobuf.GeneratedCodeInfo")
+	proto.RegisterType((*GeneratedCodeInfo_Annotation)(nil), "google.protobuf.GeneratedCodeInfo.Annotation")
+}
+
+func init() { proto.RegisterFile("google/protobuf/descriptor.proto", fileDescriptor_e5baabe45344a177) }
+
+var fileDescriptor_e5baabe45344a177 = []byte{
+	// 4200 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x4b, 0x8c, 0x1b, 0x47,
+	0x76, 0xee, 0xe6, 0xaf, 0xf9, 0xc8, 0x21, 0x6b, 0x6a, 0x46, 0x12, 0x3d, 0x5a, 0xaf, 0xc6, 0x94,
+	0x2d, 0x8d, 0x64, 0x9b, 0xb6, 0x47, 0xb2, 0x2c, 0xc9, 0x8b, 0xdd, 0x70, 0xc8, 0x9e, 0x11, 0xc7,
+	0x1c, 0x92, 0x69, 0x72, 0x64, 0xc9, 0x48, 0xd0, 0xe8, 0x69, 0x16, 0x39, 0x2d, 0x93, 0xdd, 0xbd,
+	0xdd, 0x4d, 0x49, 0xe3, 0x83, 0x61, 0x6c, 0x82, 0x60, 0x03, 0x67, 0x81, 0x5d, 0x20, 0x08, 0x9c,
+	0x00, 0x9b, 0x00, 0x7b, 0x31, 0x10, 0x64, 0x2f, 0x39, 0x04, 0xc8, 0x31, 0xf7, 0x20, 0x48, 0x0e,
+	0x01, 0x72, 0x0f, 0x60, 0x69, 0x93, 0x43, 0x2e, 0x39, 0xec, 0xcd, 0x41, 0x55, 0x75, 0x37, 0xbb,
+	0x49, 0x8e, 0x66, 0x24, 0x60, 0x03, 0x9d, 0x86, 0xf5, 0x7e, 0xf5, 0xea, 0xd5, 0xab, 0xf7, 0x5e,
+	0xbd, 0xea, 0x81, 0xf5, 0xa1, 0x65, 0x0d, 0x47, 0xe4, 0x5d, 0xdb, 0xb1, 0x3c, 0xeb, 0x60, 0x32,
+	0x78, 0xb7, 0x4f, 0x5c, 0xdd, 0x31, 0x6c, 0xcf, 0x72, 0x2a, 0x0c, 0x86, 0x8b, 0x9c, 0xa2, 0x12,
+	0x50, 0x94, 0xf7, 0x60, 0x79, 0xdb, 0x18, 0x91, 0x7a, 0x48, 0xd8, 0x25, 0x1e, 0xbe, 0x09, 0xc9,
+	0x81, 0x31, 0x22, 0x25, 0x61, 0x3d, 0xb1, 0x91, 0xdb, 0x7c, 0xa3, 0x32, 0xc3, 0x54, 0x89, 0x73,
+	0x74, 0x28, 0x58, 0x61, 0x1c, 0xe5, 0xaf, 0x53, 0xb0, 0xb2, 0x00, 0x8b, 0x31, 0x24, 0x4d, 0x6d,
+	0x4c, 0x25, 0x0a, 0x1b, 0x59, 0x85, 0xfd, 0xc6, 0x25, 0xc8, 0xd8, 0x9a, 0xfe, 0x99, 0x36, 0x24,
+	0x25, 0x91, 0x81, 0x83, 0x21, 0xfe, 0x3e, 0x40, 0x9f, 0xd8, 0xc4, 0xec, 0x13, 0x53, 0x3f, 0x2a,
+	0x25, 0xd6, 0x13, 0x1b, 0x59, 0x25, 0x02, 0xc1, 0x6f, 0xc1, 0xb2, 0x3d, 0x39, 0x18, 0x19, 0xba,
+	0x1a, 0x21, 0x83, 0xf5, 0xc4, 0x46, 0x4a, 0x41, 0x1c, 0x51, 0x9f, 0x12, 0x5f, 0x86, 0xe2, 0x23,
+	0xa2, 0x7d, 0x16, 0x25, 0xcd, 0x31, 0xd2, 0x02, 0x05, 0x47, 0x08, 0x6b, 0x90, 0x1f, 0x13, 0xd7,
+	0xd5, 0x86, 0x44, 0xf5, 0x8e, 0x6c, 0x52, 0x4a, 0xb2, 0xd5, 0xaf, 0xcf, 0xad, 0x7e, 0x76, 0xe5,
+	0x39, 0x9f, 0xab, 0x77, 0x64, 0x13, 0x5c, 0x85, 0x2c, 0x31, 0x27, 0x63, 0x2e, 0x21, 0x75, 0x8c,
+	0xfd, 0x64, 0x73, 0x32, 0x9e, 0x95, 0x22, 0x51, 0x36, 0x5f, 0x44, 0xc6, 0x25, 0xce, 0x43, 0x43,
+	0x27, 0xa5, 0x34, 0x13, 0x70, 0x79, 0x4e, 0x40, 0x97, 0xe3, 0x67, 0x65, 0x04, 0x7c, 0xb8, 0x06,
+	0x59, 0xf2, 0xd8, 0x23, 0xa6, 0x6b, 0x58, 0x66, 0x29, 0xc3, 0x84, 0xbc, 0xb9, 0x60, 0x17, 0xc9,
+	0xa8, 0x3f, 0x2b, 0x62, 0xca, 0x87, 0x6f, 0x40, 0xc6, 0xb2, 0x3d, 0xc3, 0x32, 0xdd, 0x92, 0xb4,
+	0x2e, 0x6c, 0xe4, 0x36, 0xbf, 0xb7, 0xd0, 0x11, 0xda, 0x9c, 0x46, 0x09, 0x88, 0x71, 0x03, 0x90,
+	0x6b, 0x4d, 0x1c, 0x9d, 0xa8, 0xba, 0xd5, 0x27, 0xaa, 0x61, 0x0e, 0xac, 0x52, 0x96, 0x09, 0xb8,
+	0x30, 0xbf, 0x10, 0x46, 0x58, 0xb3, 0xfa, 0xa4, 0x61, 0x0e, 0x2c, 0xa5, 0xe0, 0xc6, 0xc6, 0xf8,
+	0x2c, 0xa4, 0xdd, 0x23, 0xd3, 0xd3, 0x1e, 0x97, 0xf2, 0xcc, 0x43, 0xfc, 0x11, 0xde, 0x84, 0x0c,
+	0xe9, 0x1b, 0x74, 0xba, 0x52, 0x61, 0x5d, 0xd8, 0x28, 0x6c, 0x96, 0xe6, 0x6d, 0xcc, 0xf1, 0x4a,
+	0x40, 0x58, 0xfe, 0xc7, 0x34, 0x14, 0x4f, 0xe3, 0x96, 0x1f, 0x41, 0x6a, 0x40, 0x2d, 0x53, 0x12,
+	0x9f, 0xc7, 0x6e, 0x9c, 0x27, 0x6e, 0xf8, 0xf4, 0x0b, 0x1a, 0xbe, 0x0a, 0x39, 0x93, 0xb8, 0x1e,
+	0xe9, 0x73, 0x2f, 0x4a, 0x9c, 0xd2, 0x0f, 0x81, 0x33, 0xcd, 0xbb, 0x61, 0xf2, 0x85, 0xdc, 0xf0,
+	0x1e, 0x14, 0x43, 0x95, 0x54, 0x47, 0x33, 0x87, 0x81, 0x3f, 0xbf, 0x7b, 0x92, 0x26, 0x15, 0x39,
+	0xe0, 0x53, 0x28, 0x9b, 0x52, 0x20, 0xb1, 0x31, 0xae, 0x03, 0x58, 0x26, 0xb1, 0x06, 0x6a, 0x9f,
+	0xe8, 0xa3, 0x92, 0x74, 0x8c, 0x95, 0xda, 0x94, 0x64, 0xce, 0x4a, 0x16, 0x87, 0xea, 0x23, 0x7c,
+	0x6b, 0xea, 0x9e, 0x99, 0x63, 0xbc, 0x6b, 0x8f, 0x1f, 0xcc, 0x39, 0x0f, 0xdd, 0x87, 0x82, 0x43,
+	0xe8, 0x59, 0x21, 0x7d, 0x7f, 0x65, 0x59, 0xa6, 0x44, 0xe5, 0xc4, 0x95, 0x29, 0x3e, 0x1b, 0x5f,
+	0xd8, 0x92, 0x13, 0x1d, 0xe2, 0x8b, 0x10, 0x02, 0x54, 0xe6, 0x56, 0xc0, 0x22, 0x57, 0x3e, 0x00,
+	0xb6, 0xb4, 0x31, 0x59, 0xfb, 0x1c, 0x0a, 0x71, 0xf3, 0xe0, 0x55, 0x48, 0xb9, 0x9e, 0xe6, 0x78,
+	0xcc, 0x0b, 0x53, 0x0a, 0x1f, 0x60, 0x04, 0x09, 0x62, 0xf6, 0x59, 0x64, 0x4c, 0x29, 0xf4, 0x27,
+	0xfe, 0xbd, 0xe9, 0x82, 0x13, 0x6c, 0xc1, 0x97, 0xe6, 0x77, 0x34, 0x26, 0x79, 0x76, 0xdd, 0x6b,
+	0x1f, 0xc2, 0x52, 0x6c, 0x01, 0xa7, 0x9d, 0xba, 0xfc, 0xcf, 0x49, 0x38, 0xb3, 0x50, 0x36, 0xbe,
+	0x07, 0xab, 0x13, 0xd3, 0x30, 0x3d, 0xe2, 0xd8, 0x0e, 0xa1, 0x2e, 0xcb, 0xe7, 0x2a, 0x3d, 0xc9,
+	0x1c, 0xe3, 0x74, 0xfb, 0x51, 0x6a, 0x2e, 0x45, 0x59, 0x99, 0xcc, 0x03, 0xf1, 0x7d, 0xc8, 0x51,
+	0xff, 0xd0, 0x1c, 0x8d, 0x09, 0xe4, 0xa7, 0x71, 0xf3, 0x74, 0x4b, 0xae, 0xd4, 0xa7, 0x9c, 0x5b,
+	0x89, 0x9f, 0x0a, 0xa2, 0x12, 0x95, 0x85, 0x3f, 0x04, 0x69, 0x40, 0x34, 0x6f, 0xe2, 0x10, 0xb7,
+	0xb4, 0xc9, 0x4c, 0x79, 0x7e, 0xfe, 0x90, 0x72, 0x82, 0x2e, 0xf1, 0x94, 0x90, 0x18, 0x8f, 0x21,
+	0xff, 0x90, 0x38, 0xc6, 0xc0, 0xd0, 0xb9, 0x52, 0x09, 0x16, 0x7c, 0x6e, 0x9e, 0x52, 0xa9, 0xbb,
+	0x11, 0xd6, 0xae, 0xa7, 0x79, 0xe4, 0x36, 0xec, 0xb7, 0xee, 0xca, 0x4a, 0x63, 0xbb, 0x21, 0xd7,
+	0xb9, 0x9a, 0x31, 0xf1, 0x6b, 0x7f, 0x2e, 0x40, 0x2e, 0xb2, 0x12, 0x1a, 0x0e, 0xcd, 0xc9, 0xf8,
+	0x80, 0x38, 0xfe, 0x7e, 0xf9, 0x23, 0x7c, 0x1e, 0xb2, 0x83, 0xc9, 0x68, 0xc4, 0x9d, 0x8e, 0xe7,
+	0x52, 0x89, 0x02, 0xa8, 0xc3, 0xd1, 0x18, 0xe7, 0x87, 0x11, 0x16, 0xe3, 0xe8, 0x6f, 0xbc, 0x06,
+	0x52, 0xe0, 0x94, 0xa5, 0xd4, 0xba, 0xb0, 0x21, 0x29, 0xe1, 0x98, 0xe3, 0x6c, 0xa2, 0x79, 0xa4,
+	0x5f, 0x4a, 0x07, 0x38, 0x3e, 0xde, 0x4d, 0x4a, 0x49, 0x94, 0x2a, 0x5f, 0x87, 0xe5, 0xb9, 0xa5,
+	0xe0, 0x22, 0xe4, 0xea, 0x72, 0xad, 0x59, 0x55, 0xaa, 0xbd, 0x46, 0xbb, 0x85, 0x5e, 0xc1, 0x05,
+	0x88, 0xac, 0x0e, 0x09, 0x57, 0xb3, 0xd2, 0xd3, 0x0c, 0xfa, 0xf2, 0xcb, 0x2f, 0xbf, 0x14, 0xcb,
+	0xff, 0x94, 0x86, 0xd5, 0x45, 0x41, 0x70, 0x61, 0x3c, 0x9e, 0x2e, 0x3a, 0x11, 0x5b, 0x74, 0x15,
+	0x52, 0x23, 0xed, 0x80, 0x8c, 0x4a, 0x49, 0xb6, 0x09, 0x6f, 0x9d, 0x2a, 0xcc, 0x56, 0x9a, 0x94,
+	0x45, 0xe1, 0x9c, 0xf8, 0x87, 0xbe, 0x69, 0x52, 0x4c, 0xc2, 0xd5, 0xd3, 0x49, 0xa0, 0xc1, 0xd1,
+	0x37, 0xe3, 0x79, 0xc8, 0xd2, 0xbf, 0xdc, 0xee, 0x69, 0x6e, 0x77, 0x0a, 0x60, 0x76, 0x5f, 0x03,
+	0x89, 0xc5, 0xbd, 0x3e, 0x09, 0xf7, 0x24, 0x18, 0xd3, 0x48, 0xd1, 0x27, 0x03, 0x6d, 0x32, 0xf2,
+	0xd4, 0x87, 0xda, 0x68, 0x42, 0x58, 0x04, 0xcb, 0x2a, 0x79, 0x1f, 0x78, 0x97, 0xc2, 0xf0, 0x05,
+	0xc8, 0xf1, 0x30, 0x69, 0x98, 0x7d, 0xf2, 0x98, 0xa5, 0xd0, 0x94, 0xc2, 0x23, 0x67, 0x83, 0x42,
+	0xe8, 0xf4, 0x0f, 0x5c, 0xcb, 0x0c, 0x62, 0x0d, 0x9b, 0x82, 0x02, 0xd8, 0xf4, 0x1f, 0xce, 0x66,
+	0xef, 0xd7, 0x16, 0x2f, 0x6f, 0x2e, 0x38, 0x5e, 0x86, 0x22, 0xa3, 0xb8, 0xe6, 0x1f, 0x65, 0x6d,
+	0x54, 0x5a, 0x66, 0x6e, 0x50, 0xe0, 0xe0, 0xb6, 0x0f, 0x2d, 0xff, 0x83, 0x08, 0x49, 0x96, 0x29,
+	0x8a, 0x90, 0xeb, 0xdd, 0xef, 0xc8, 0x6a, 0xbd, 0xbd, 0xbf, 0xd5, 0x94, 0x91, 0x40, 0xb7, 0x9e,
+	0x01, 0xb6, 0x9b, 0xed, 0x6a, 0x0f, 0x89, 0xe1, 0xb8, 0xd1, 0xea, 0xdd, 0xb8, 0x8e, 0x12, 0x21,
+	0xc3, 0x3e, 0x07, 0x24, 0xa3, 0x04, 0xd7, 0x36, 0x51, 0x0a, 0x23, 0xc8, 0x73, 0x01, 0x8d, 0x7b,
+	0x72, 0xfd, 0xc6, 0x75, 0x94, 0x8e, 0x43, 0xae, 0x6d, 0xa2, 0x0c, 0x5e, 0x82, 0x2c, 0x83, 0x6c,
+	0xb5, 0xdb, 0x4d, 0x24, 0x85, 0x32, 0xbb, 0x3d, 0xa5, 0xd1, 0xda, 0x41, 0xd9, 0x50, 0xe6, 0x8e,
+	0xd2, 0xde, 0xef, 0x20, 0x08, 0x25, 0xec, 0xc9, 0xdd, 0x6e, 0x75, 0x47, 0x46, 0xb9, 0x90, 0x62,
+	0xeb, 0x7e, 0x4f, 0xee, 0xa2, 0x7c, 0x4c, 0xad, 0x6b, 0x9b, 0x68, 0x29, 0x9c, 0x42, 0x6e, 0xed,
+	0xef, 0xa1, 0x02, 0x5e, 0x86, 0x25, 0x3e, 0x45, 0xa0, 0x44, 0x71, 0x06, 0x74, 0xe3, 0x3a, 0x42,
+	0x53, 0x45, 0xb8, 0x94, 0xe5, 0x18, 0xe0, 0xc6, 0x75, 0x84, 0xcb, 0x35, 0x48, 0x31, 0x37, 0xc4,
+	0x18, 0x0a, 0xcd, 0xea, 0x96, 0xdc, 0x54, 0xdb, 0x1d, 0x7a, 0x68, 0xaa, 0x4d, 0x24, 0x4c, 0x61,
+	0x8a, 0xdc, 0x91, 0xab, 0x3d, 0xb9, 0x8e, 0x12, 0x51, 0xd8, 0xef, 0xef, 0x37, 0x14, 0xb9, 0x8e,
+	0xc4, 0xb2, 0x0e, 0xab, 0x8b, 0x32, 0xe4, 0xc2, 0x23, 0x14, 0xf1, 0x05, 0xf1, 0x18, 0x5f, 0x60,
+	0xb2, 0x66, 0x7d, 0xa1, 0xfc, 0x9f, 0x22, 0xac, 0x2c, 0xa8, 0x12, 0x16, 0x4e, 0xf2, 0x23, 0x48,
+	0x71, 0x5f, 0xe6, 0x91, 0xfa, 0xca, 0xc2, 0x72, 0x83, 0x79, 0xf6, 0x5c, 0xed, 0xc4, 0xf8, 0xa2,
+	0xf5, 0x66, 0xe2, 0x98, 0x7a, 0x93, 0x8a, 0x98, 0x73, 0xd8, 0x3f, 0x9c, 0xcb, 0xe6, 0xbc, 0xe0,
+	0xb9, 0x71, 0x9a, 0x82, 0x87, 0xc1, 0x9e, 0x2f, 0xab, 0xa7, 0x16, 0x64, 0xf5, 0x8f, 0x60, 0x79,
+	0x4e, 0xd0, 0xa9, 0xb3, 0xeb, 0x1f, 0x09, 0x50, 0x3a, 0xce, 0x38, 0x27, 0x84, 0x44, 0x31, 0x16,
+	0x12, 0x3f, 0x9a, 0xb5, 0xe0, 0xeb, 0xc7, 0x6f, 0xc2, 0xdc, 0x5e, 0x7f, 0x23, 0xc0, 0xd9, 0xc5,
+	0xf7, 0x8a, 0x85, 0x3a, 0xfc, 0x10, 0xd2, 0x63, 0xe2, 0x1d, 0x5a, 0x41, 0x9d, 0x7c, 0x69, 0x41,
+	0xf5, 0x45, 0xd1, 0xb3, 0x9b, 0xed, 0x73, 0xe1, 0x5b, 0xb3, 0xba, 0x5e, 0x38, 0xee, 0x96, 0x33,
+	0xa7, 0xe9, 0x9f, 0x8a, 0x70, 0x66, 0xa1, 0xf0, 0x85, 0x8a, 0xbe, 0x06, 0x60, 0x98, 0xf6, 0xc4,
+	0xe3, 0xb5, 0x30, 0x8f, 0xc4, 0x59, 0x06, 0x61, 0xc1, 0x8b, 0x46, 0xd9, 0x89, 0x17, 0xe2, 0x79,
+	0x96, 0x04, 0x0e, 0x62, 0x04, 0x37, 0xa7, 0x8a, 0x26, 0x99, 0xa2, 0xdf, 0x3f, 0x66, 0xa5, 0x73,
+	0x8e, 0xf9, 0x1e, 0x20, 0x7d, 0x64, 0x10, 0xd3, 0x53, 0x5d, 0xcf, 0x21, 0xda, 0xd8, 0x30, 0x87,
+	0x3c, 0xdb, 0xde, 0x4e, 0x0d, 0xb4, 0x91, 0x4b, 0x94, 0x22, 0x47, 0x77, 0x03, 0x2c, 0xe5, 0x60,
+	0x0e, 0xe4, 0x44, 0x38, 0xd2, 0x31, 0x0e, 0x8e, 0x0e, 0x39, 0xca, 0xbf, 0xce, 0x42, 0x2e, 0x72,
+	0x0b, 0xc3, 0xaf, 0x43, 0xfe, 0x81, 0xf6, 0x50, 0x53, 0x83, 0x9b, 0x35, 0xb7, 0x44, 0x8e, 0xc2,
+	0x3a, 0x1c, 0x84, 0xdf, 0x83, 0x55, 0x46, 0x62, 0x4d, 0x3c, 0xe2, 0xa8, 0xfa, 0x48, 0x73, 0x5d,
+	0x66, 0x34, 0x89, 0x91, 0x62, 0x8a, 0x6b, 0x53, 0x54, 0x2d, 0xc0, 0xe0, 0x0f, 0x60, 0x85, 0x71,
+	0x8c, 0x27, 0x23, 0xcf, 0xb0, 0x47, 0x44, 0xa5, 0x77, 0x7d, 0xb7, 0x04, 0x51, 0xcd, 0x96, 0x29,
+	0xc5, 0x9e, 0x4f, 0x40, 0x35, 0x72, 0x71, 0x1d, 0x5e, 0x63, 0x6c, 0x43, 0x62, 0x12, 0x47, 0xf3,
+	0x88, 0x4a, 0x7e, 0x3c, 0xd1, 0x46, 0xae, 0xaa, 0x99, 0x7d, 0xf5, 0x50, 0x73, 0x0f, 0x4b, 0xab,
+	0x54, 0xc0, 0x96, 0x58, 0x12, 0x94, 0x57, 0x29, 0xe1, 0x8e, 0x4f, 0x27, 0x33, 0xb2, 0xaa, 0xd9,
+	0xbf, 0xa3, 0xb9, 0x87, 0xf8, 0x36, 0x9c, 0x65, 0x52, 0x5c, 0xcf, 0x31, 0xcc, 0xa1, 0xaa, 0x1f,
+	0x12, 0xfd, 0x33, 0x75, 0xe2, 0x0d, 0x6e, 0x96, 0xce, 0x47, 0xe7, 0x67, 0x1a, 0x76, 0x19, 0x4d,
+	0x8d, 0x92, 0xec, 0x7b, 0x83, 0x9b, 0xb8, 0x0b, 0x79, 0xba, 0x19, 0x63, 0xe3, 0x73, 0xa2, 0x0e,
+	0x2c, 0x87, 0xe5, 0xd0, 0xc2, 0x82, 0xd0, 0x14, 0xb1, 0x60, 0xa5, 0xed, 0x33, 0xec, 0x59, 0x7d,
+	0x72, 0x3b, 0xd5, 0xed, 0xc8, 0x72, 0x5d, 0xc9, 0x05, 0x52, 0xb6, 0x2d, 0x87, 0x3a, 0xd4, 0xd0,
+	0x0a, 0x0d, 0x9c, 0xe3, 0x0e, 0x35, 0xb4, 0x02, 0xf3, 0x7e, 0x00, 0x2b, 0xba, 0xce, 0xd7, 0x6c,
+	0xe8, 0xaa, 0x7f, 0x23, 0x77, 0x4b, 0x28, 0x66, 0x2c, 0x5d, 0xdf, 0xe1, 0x04, 0xbe, 0x8f, 0xbb,
+	0xf8, 0x16, 0x9c, 0x99, 0x1a, 0x2b, 0xca, 0xb8, 0x3c, 0xb7, 0xca, 0x59, 0xd6, 0x0f, 0x60, 0xc5,
+	0x3e, 0x9a, 0x67, 0xc4, 0xb1, 0x19, 0xed, 0xa3, 0x59, 0xb6, 0x37, 0x59, 0x97, 0xc5, 0x21, 0x3a,
+	0x2b, 0xf5, 0xce, 0x45, 0xa9, 0x23, 0x08, 0x5c, 0x01, 0xa4, 0xeb, 0x2a, 0x31, 0xb5, 0x83, 0x11,
+	0x51, 0x35, 0x87, 0x98, 0x9a, 0x5b, 0xba, 0xc0, 0x88, 0x93, 0x9e, 0x33, 0x21, 0x4a, 0x41, 0xd7,
+	0x65, 0x86, 0xac, 0x32, 0x1c, 0xbe, 0x0a, 0xcb, 0xd6, 0xc1, 0x03, 0x9d, 0x3b, 0x96, 0x6a, 0x3b,
+	0x64, 0x60, 0x3c, 0x2e, 0xbd, 0xc1, 0xac, 0x54, 0xa4, 0x08, 0xe6, 0x56, 0x1d, 0x06, 0xc6, 0x57,
+	0x00, 0xe9, 0xee, 0xa1, 0xe6, 0xd8, 0x2c, 0xb2, 0xba, 0xb6, 0xa6, 0x93, 0xd2, 0x9b, 0x9c, 0x94,
+	0xc3, 0x5b, 0x01, 0x98, 0x3a, 0xb6, 0xfb, 0xc8, 0x18, 0x78, 0x81, 0xc4, 0xcb, 0xdc, 0xb1, 0x19,
+	0xcc, 0x97, 0xb6, 0x01, 0xc8, 0x3e, 0xb4, 0xe3, 0x13, 0x6f, 0x30, 0xb2, 0x82, 0x7d, 0x68, 0x47,
+	0xe7, 0xbd, 0x08, 0x4b, 0xf6, 0x61, 0x74, 0xd2, 0x2b, 0xbc, 0xfe, 0xb2, 0x0f, 0x23, 0x33, 0x5e,
+	0x87, 0xb3, 0x94, 0x68, 0x4c, 0x3c, 0xad, 0xaf, 0x79, 0x5a, 0x84, 0xfa, 0x6d, 0x46, 0xbd, 0x6a,
+	0x1f, 0xda, 0x7b, 0x3e, 0x32, 0xa6, 0xa7, 0x33, 0x39, 0x38, 0x0a, 0xfd, 0xe3, 0x1d, 0xae, 0x27,
+	0x85, 0x05, 0x1e, 0xf2, 0xc2, 0xd7, 0x8f, 0xdf, 0xd9, 0x65, 0xab, 0x7c, 0x1b, 0xf2, 0x51, 0xbf,
+	0xc7, 0x59, 0xe0, 0x9e, 0x8f, 0x04, 0x5a, 0x04, 0xd5, 0xda, 0x75, 0x5a, 0xbe, 0x7c, 0x2a, 0x23,
+	0x91, 0x96, 0x51, 0xcd, 0x46, 0x4f, 0x56, 0x95, 0xfd, 0x56, 0xaf, 0xb1, 0x27, 0xa3, 0x44, 0xa4,
+	0xb0, 0xdf, 0x4d, 0x4a, 0x57, 0xd1, 0x5b, 0xbb, 0x49, 0xe9, 0x12, 0xba, 0xcc, 0xcc, 0x33, 0xe7,
+	0x94, 0xe5, 0xff, 0x4d, 0x40, 0x21, 0x7e, 0x2d, 0xc7, 0x3f, 0x80, 0x73, 0x41, 0xdf, 0xcd, 0x25,
+	0x9e, 0xfa, 0xc8, 0x70, 0xd8, 0x61, 0x1d, 0x6b, 0x3c, 0x71, 0x86, 0x4e, 0xb9, 0xea, 0x53, 0x75,
+	0x89, 0xf7, 0x89, 0xe1, 0xd0, 0xa3, 0x38, 0xd6, 0x3c, 0xdc, 0x84, 0x0b, 0xa6, 0xa5, 0xba, 0x9e,
+	0x66, 0xf6, 0x35, 0xa7, 0xaf, 0x4e, 0x3b, 0x9e, 0xaa, 0xa6, 0xeb, 0xc4, 0x75, 0x2d, 0x9e, 0x24,
+	0x43, 0x29, 0xdf, 0x33, 0xad, 0xae, 0x4f, 0x3c, 0xcd, 0x1e, 0x55, 0x9f, 0x74, 0xe6, 0x4c, 0x24,
+	0x8e, 0x3b, 0x13, 0xe7, 0x21, 0x3b, 0xd6, 0x6c, 0x95, 0x98, 0x9e, 0x73, 0xc4, 0x6a, 0x77, 0x49,
+	0x91, 0xc6, 0x9a, 0x2d, 0xd3, 0x31, 0xbe, 0x0b, 0x97, 0xa6, 0xa4, 0xea, 0x88, 0x0c, 0x35, 0xfd,
+	0x48, 0x65, 0x85, 0x3a, 0xeb, 0x11, 0xa9, 0xba, 0x65, 0x0e, 0x46, 0x86, 0xee, 0xb9, 0xa5, 0x5c,
+	0x18, 0xff, 0xca, 0x53, 0x8e, 0x26, 0x63, 0xd8, 0x75, 0x2d, 0x93, 0xd5, 0xe7, 0xb5, 0x80, 0x3a,
+	0xe6, 0x36, 0xf9, 0x97, 0xc2, 0x6d, 0xe2, 0x5b, 0x9f, 0x44, 0xa9, 0xdd, 0xa4, 0x94, 0x42, 0xe9,
+	0xdd, 0xa4, 0x94, 0x46, 0x99, 0xdd, 0xa4, 0x24, 0xa1, 0xec, 0x6e, 0x52, 0xca, 0x22, 0x28, 0xff,
+	0x72, 0x09, 0xf2, 0xd1, 0xeb, 0x06, 0xbd, 0xbd, 0xe9, 0x2c, 0xe1, 0x0a, 0x2c, 0x24, 0x5f, 0x7c,
+	0xe6, 0xe5, 0xa4, 0x52, 0xa3, 0x99, 0xf8, 0x76, 0x9a, 0xd7, 0xf6, 0x0a, 0xe7, 0xa4, 0x55, 0x10,
+	0x3d, 0x64, 0x84, 0xd7, 0x52, 0x92, 0xe2, 0x8f, 0xf0, 0x0e, 0xa4, 0x1f, 0xb8, 0x4c, 0x76, 0x9a,
+	0xc9, 0x7e, 0xe3, 0xd9, 0xb2, 0x77, 0xbb, 0x4c, 0x78, 0x76, 0xb7, 0xab, 0xb6, 0xda, 0xca, 0x5e,
+	0xb5, 0xa9, 0xf8, 0xec, 0xf8, 0x55, 0x48, 0x8e, 0xb4, 0xcf, 0x8f, 0xe2, 0x39, 0x9b, 0x81, 0x70,
+	0x05, 0x8a, 0x13, 0x93, 0xdf, 0xd5, 0xe9, 0x1e, 0x53, 0xaa, 0x62, 0x94, 0xaa, 0x30, 0xc5, 0x36,
+	0x29, 0xfd, 0x29, 0xfd, 0xea, 0x55, 0x48, 0xd2, 0xa6, 0x74, 0x3c, 0xb3, 0x32, 0x10, 0xde, 0x80,
+	0x7c, 0x9f, 0x1c, 0x4c, 0x86, 0xaa, 0x43, 0xfa, 0x9a, 0xee, 0xc5, 0xf3, 0x49, 0x8e, 0xa1, 0x14,
+	0x86, 0xc1, 0x1f, 0x43, 0x96, 0xee, 0x91, 0xc9, 0xf6, 0x78, 0x99, 0x99, 0xe0, 0x9d, 0x67, 0x9b,
+	0xc0, 0xdf, 0xe2, 0x80, 0x49, 0x99, 0xf2, 0xe3, 0x3b, 0x90, 0xf1, 0x34, 0x67, 0x48, 0x3c, 0xb7,
+	0xb4, 0xb2, 0x9e, 0xd8, 0x28, 0x6c, 0x56, 0x4e, 0x23, 0xaa, 0xc7, 0x58, 0xd8, 0x4d, 0x39, 0x60,
+	0xc7, 0x9f, 0x00, 0xf2, 0x5b, 0xb1, 0xaa, 0x7f, 0xcd, 0x75, 0x4b, 0xab, 0xcc, 0x01, 0xdf, 0x7e,
+	0xb6, 0x48, 0xbf, 0x93, 0x5b, 0xe7, 0x4c, 0x4a, 0x91, 0xc4, 0xc6, 0xf1, 0x73, 0x71, 0xe6, 0x79,
+	0xce, 0xc5, 0x3e, 0x14, 0xfd, 0xdf, 0xaa, 0x3b, 0xb1, 0x6d, 0xcb, 0xf1, 0x4a, 0x67, 0xd7, 0x85,
+	0x93, 0x15, 0x0a, 0x84, 0x71, 0x1e, 0xa5, 0x30, 0x88, 0x8d, 0x7f, 0x77, 0xc7, 0x6d, 0xed, 0x53,
+	0x28, 0xc4, 0x8d, 0x11, 0x6d, 0x84, 0x27, 0x4e, 0xd9, 0x08, 0xa7, 0xd7, 0x92, 0xe0, 0xa2, 0x46,
+	0x53, 0x13, 0x1f, 0xac, 0xfd, 0x85, 0x08, 0x85, 0xf8, 0xc2, 0xf0, 0x0e, 0xe0, 0x60, 0xc7, 0x0c,
+	0xd3, 0x73, 0xac, 0xfe, 0x44, 0x27, 0xfd, 0x92, 0x70, 0xc2, 0x3c, 0xcb, 0x3e, 0x4f, 0x23, 0x64,
+	0x89, 0x0a, 0x8a, 0x9c, 0x02, 0xf1, 0x94, 0x82, 0xea, 0xd3, 0xf3, 0xf1, 0x2e, 0xac, 0x04, 0x02,
+	0xa8, 0xb0, 0x47, 0x9a, 0x63, 0xd2, 0x12, 0x99, 0x17, 0xed, 0x38, 0x82, 0xfa, 0x84, 0x63, 0x70,
+	0x15, 0x02, 0x77, 0x51, 0x1d, 0x32, 0xb6, 0x68, 0xbf, 0x2b, 0x79, 0xc2, 0xb4, 0x05, 0x9f, 0x41,
+	0xe1, 0xf4, 0xe5, 0x77, 0x21, 0xc5, 0xc2, 0x0f, 0x06, 0xf0, 0x03, 0x10, 0x7a, 0x05, 0x4b, 0x90,
+	0xac, 0xb5, 0x15, 0x9a, 0x1e, 0x11, 0xe4, 0x39, 0x54, 0xed, 0x34, 0xe4, 0x9a, 0x8c, 0xc4, 0xf2,
+	0x07, 0x90, 0xe6, 0x31, 0x85, 0xa6, 0xce, 0x30, 0xaa, 0xa0, 0x57, 0xfc, 0xa1, 0x2f, 0x43, 0x08,
+	0xb0, 0xfb, 0x7b, 0x5b, 0xb2, 0x82, 0xc4, 0xf2, 0x3e, 0x14, 0x67, 0xce, 0x21, 0x3e, 0x03, 0xcb,
+	0x8a, 0xdc, 0x93, 0x5b, 0xb4, 0x39, 0xa0, 0xee, 0xb7, 0x3e, 0x6e, 0xb5, 0x3f, 0xa1, 0x9d, 0xb5,
+	0x18, 0x38, 0xc8, 0xc3, 0x02, 0x5e, 0x05, 0x34, 0x05, 0x77, 0xdb, 0xfb, 0x0a, 0xd3, 0xe6, 0xcf,
+	0x44, 0x40, 0xb3, 0x87, 0x12, 0x9f, 0x83, 0x95, 0x5e, 0x55, 0xd9, 0x91, 0x7b, 0x2a, 0x6f, 0x78,
+	0x84, 0xa2, 0x57, 0x01, 0x45, 0x11, 0xdb, 0x0d, 0xd6, 0xcf, 0xb9, 0x00, 0xe7, 0xa3, 0x50, 0xf9,
+	0x5e, 0x4f, 0x6e, 0x75, 0xd9, 0xe4, 0xd5, 0xd6, 0x0e, 0x2d, 0x0a, 0x66, 0xe4, 0x05, 0x2d, 0x96,
+	0x04, 0x55, 0x35, 0x2e, 0x4f, 0x6e, 0xd6, 0x51, 0x72, 0x16, 0xdc, 0x6e, 0xc9, 0xed, 0x6d, 0x94,
+	0x9a, 0x9d, 0x9d, 0xb5, 0x5d, 0xd2, 0x78, 0x0d, 0xce, 0xce, 0x42, 0x55, 0xb9, 0xd5, 0x53, 0xee,
+	0xa3, 0xcc, 0xec, 0xc4, 0x5d, 0x59, 0xb9, 0xdb, 0xa8, 0xc9, 0x48, 0xc2, 0x67, 0x01, 0xc7, 0x35,
+	0xea, 0xdd, 0x69, 0xd7, 0x51, 0x76, 0x51, 0xc6, 0xc2, 0x68, 0xa5, 0xfc, 0x77, 0x02, 0xe4, 0xa3,
+	0x2d, 0x90, 0x58, 0x50, 0x11, 0x5e, 0xb6, 0x64, 0x5b, 0xfe, 0x37, 0x11, 0x72, 0x91, 0x5e, 0x08,
+	0xbd, 0xc4, 0x6a, 0xa3, 0x91, 0xf5, 0x48, 0xd5, 0x46, 0x86, 0xe6, 0xfa, 0xf9, 0x10, 0x18, 0xa8,
+	0x4a, 0x21, 0xa7, 0xcd, 0x3f, 0xa7, 0x2f, 0x5d, 0xd2, 0x2f, 0x5c, 0xba, 0x64, 0x5e, 0xc2, 0xd2,
+	0x25, 0x85, 0xd2, 0xe5, 0xff, 0x10, 0x01, 0xcd, 0x76, 0x47, 0x66, 0xec, 0x26, 0x1c, 0x67, 0xb7,
+	0xe8, 0xfa, 0xc4, 0xe7, 0x59, 0xdf, 0x6c, 0x56, 0x4f, 0x1c, 0x9b, 0xd5, 0x17, 0x24, 0xab, 0xe4,
+	0xcb, 0x9c, 0xac, 0xa2, 0xee, 0xfa, 0xef, 0x02, 0x14, 0xe2, 0xcd, 0x9c, 0x98, 0xc5, 0xca, 0xcf,
+	0x63, 0xb1, 0xf8, 0x8e, 0xbc, 0x7e, 0xdc, 0x8e, 0xfc, 0xbf, 0xac, 0xeb, 0x2f, 0x13, 0xb0, 0x14,
+	0xeb, 0xfd, 0x9c, 0x56, 0xbb, 0x1f, 0xc3, 0xb2, 0xd1, 0x27, 0x63, 0xdb, 0xf2, 0xe8, 0x97, 0x07,
+	0xea, 0x88, 0x3c, 0x24, 0x23, 0x66, 0x86, 0xc2, 0x82, 0xd7, 0xd5, 0xd8, 0x0c, 0x95, 0xc6, 0x94,
+	0xaf, 0x49, 0xd9, 0x6e, 0xaf, 0x34, 0xea, 0xf2, 0x5e, 0xa7, 0xdd, 0x93, 0x5b, 0xb5, 0xfb, 0x41,
+	0x24, 0x57, 0x90, 0x31, 0x43, 0x16, 0x33, 0xf8, 0xc5, 0x97, 0xe3, 0xd2, 0xd9, 0x01, 0x34, 0xbb,
+	0x1a, 0x1a, 0xd0, 0x17, 0xac, 0x07, 0xbd, 0x82, 0x57, 0xa0, 0xd8, 0x6a, 0xab, 0xdd, 0x46, 0x5d,
+	0x56, 0xe5, 0xed, 0x6d, 0xb9, 0xd6, 0xeb, 0xf2, 0x87, 0x86, 0x90, 0xba, 0x87, 0xc4, 0xe8, 0xde,
+	0xfc, 0x55, 0x02, 0x56, 0x16, 0x68, 0x82, 0xab, 0x7e, 0x8b, 0x90, 0x77, 0x2d, 0xdf, 0x39, 0x8d,
+	0xf6, 0x15, 0x7a, 0xbb, 0xef, 0x68, 0x8e, 0xe7, 0x77, 0x14, 0xaf, 0x00, 0x35, 0xaf, 0xe9, 0xd1,
+	0xf2, 0xde, 0xf1, 0x1f, 0x70, 0x78, 0x09, 0x52, 0x9c, 0xc2, 0xf9, 0x1b, 0xce, 0xdb, 0x80, 0x6d,
+	0xcb, 0x35, 0x3c, 0xe3, 0x21, 0xfd, 0x10, 0x22, 0x78, 0xed, 0xa1, 0x07, 0x37, 0xa9, 0xa0, 0x00,
+	0xd3, 0x30, 0xbd, 0x90, 0xda, 0x24, 0x43, 0x6d, 0x86, 0x9a, 0x5e, 0x3f, 0x12, 0x0a, 0x0a, 0x30,
+	0x21, 0xf5, 0xeb, 0x90, 0xef, 0x5b, 0x13, 0xda, 0x95, 0xe1, 0x74, 0x34, 0x24, 0x0b, 0x4a, 0x8e,
+	0xc3, 0x42, 0x12, 0xbf, 0x6d, 0x36, 0x7d, 0x66, 0xca, 0x2b, 0x39, 0x0e, 0xe3, 0x24, 0x97, 0xa1,
+	0xa8, 0x0d, 0x87, 0x0e, 0x15, 0x1e, 0x08, 0xe2, 0x8d, 0xc0, 0x42, 0x08, 0x66, 0x84, 0x6b, 0xbb,
+	0x20, 0x05, 0x76, 0xa0, 0xf7, 0x5f, 0x6a, 0x09, 0xd5, 0xe6, 0xdd, 0x6d, 0x91, 0xbe, 0x3c, 0x99,
+	0x01, 0xf2, 0x75, 0xc8, 0x1b, 0xae, 0x3a, 0xfd, 0x0c, 0x42, 0x5c, 0x17, 0x37, 0x24, 0x25, 0x67,
+	0xb8, 0xe1, 0xab, 0x68, 0xf9, 0xd7, 0x39, 0x80, 0xa9, 0xb3, 0xe1, 0x5f, 0x08, 0x50, 0xe0, 0x09,
+	0xc6, 0x76, 0x88, 0x4b, 0x4c, 0x3d, 0xb8, 0x16, 0x5e, 0x79, 0x86, 0x8b, 0xf2, 0x30, 0xd7, 0xf1,
+	0x19, 0xb6, 0x7e, 0xf4, 0x53, 0x41, 0xf8, 0x5a, 0x48, 0x7e, 0x2d, 0x08, 0xbf, 0x12, 0x96, 0xb0,
+	0x24, 0xdf, 0xeb, 0x34, 0x1b, 0xb5, 0x46, 0xaf, 0xf4, 0x6d, 0x86, 0x8d, 0x1b, 0x7b, 0xfe, 0xf8,
+	0x49, 0x26, 0x8e, 0x7f, 0x9a, 0xf9, 0x7b, 0x21, 0x21, 0x3d, 0xcd, 0x28, 0x4b, 0x83, 0xa8, 0x3c,
+	0x3c, 0x8a, 0x7e, 0x41, 0x21, 0x1e, 0x77, 0x91, 0x9c, 0x6a, 0x23, 0xfb, 0xdf, 0x4d, 0x6c, 0x5d,
+	0x61, 0x8a, 0xa4, 0x99, 0x22, 0x39, 0x9c, 0xae, 0x35, 0xdb, 0x5d, 0xb9, 0xce, 0xd4, 0xc8, 0xe2,
+	0x64, 0xbb, 0x23, 0xb7, 0x4a, 0x4f, 0x82, 0x29, 0xa7, 0x1f, 0x5b, 0x7c, 0x2d, 0xc0, 0xb9, 0xe0,
+	0x95, 0xd5, 0xcf, 0xb5, 0xc4, 0xd4, 0xad, 0x7e, 0x50, 0xdd, 0x16, 0x36, 0xdf, 0x7f, 0xd6, 0xe4,
+	0x8a, 0xcf, 0xca, 0x4c, 0x22, 0xfb, 0x8c, 0x5b, 0xef, 0xcc, 0x99, 0xa4, 0xda, 0xaa, 0xfb, 0xba,
+	0xe4, 0x70, 0xba, 0x53, 0xad, 0x7d, 0x2c, 0xd7, 0xa7, 0xda, 0x9c, 0x71, 0x16, 0x49, 0xc1, 0x5f,
+	0x40, 0x91, 0x76, 0x5b, 0xa9, 0x6f, 0x18, 0x7d, 0xfe, 0xec, 0x9d, 0x3c, 0xee, 0xbd, 0x74, 0xaa,
+	0x11, 0x6d, 0xbf, 0xde, 0x0d, 0x39, 0xb6, 0xae, 0x44, 0x54, 0xc9, 0xe2, 0x64, 0xab, 0xdd, 0x92,
+	0x03, 0x35, 0xd8, 0x13, 0xf1, 0xfd, 0xa9, 0x1a, 0x85, 0x49, 0x8c, 0x15, 0x7f, 0x01, 0x28, 0x68,
+	0x0f, 0x85, 0x26, 0x49, 0x1d, 0xf7, 0xe4, 0x3b, 0x55, 0xc0, 0x6f, 0x32, 0x85, 0xc6, 0xb8, 0x14,
+	0xd1, 0x60, 0x15, 0x17, 0x9b, 0x72, 0x6b, 0xa7, 0x77, 0x47, 0xed, 0x28, 0x32, 0x7b, 0xb9, 0x2b,
+	0x7d, 0x1b, 0x4c, 0x5f, 0x1c, 0xc7, 0x19, 0xf1, 0x4f, 0x04, 0xc8, 0xf1, 0x12, 0x88, 0xf7, 0xa4,
+	0x78, 0x53, 0xe1, 0xd2, 0xb3, 0xe6, 0x66, 0x15, 0x10, 0xa3, 0xde, 0xba, 0xc5, 0xa6, 0x4d, 0x04,
+	0x0e, 0x71, 0x0e, 0xe3, 0xa6, 0xbc, 0x53, 0xad, 0xdd, 0x57, 0xb7, 0xe4, 0x6e, 0x8f, 0x46, 0xb2,
+	0xb6, 0xc2, 0x7d, 0x14, 0x70, 0xaa, 0xda, 0x6c, 0xb6, 0x3f, 0x99, 0x1a, 0x02, 0x1e, 0x84, 0x62,
+	0xca, 0x7f, 0x00, 0x4b, 0x31, 0x77, 0xa7, 0x45, 0x31, 0x2b, 0xa6, 0xe9, 0x0a, 0xba, 0x72, 0xab,
+	0x16, 0x2d, 0xe2, 0xf3, 0x10, 0xba, 0x37, 0x12, 0xe8, 0x28, 0x70, 0x7e, 0x24, 0xd2, 0x30, 0xea,
+	0x2b, 0x10, 0xbe, 0x25, 0x26, 0xca, 0x1f, 0x82, 0x14, 0xb8, 0x2f, 0x2d, 0xcd, 0x59, 0x85, 0x3d,
+	0x73, 0x31, 0x90, 0x80, 0xf9, 0x2e, 0x12, 0xe8, 0x35, 0x88, 0xfb, 0x34, 0x12, 0xcb, 0x77, 0xe1,
+	0xcc, 0x42, 0xd7, 0xc3, 0x17, 0xe1, 0x42, 0xf0, 0x7e, 0xc9, 0x8b, 0x7e, 0x55, 0x6e, 0xd5, 0xda,
+	0x75, 0x7a, 0x4d, 0x9a, 0xca, 0x04, 0xf0, 0x7d, 0x90, 0x6b, 0x19, 0xf8, 0x27, 0x12, 0xcb, 0x0d,
+	0x28, 0xc4, 0x1d, 0x08, 0x9f, 0x87, 0x73, 0xfb, 0xbd, 0xed, 0x9b, 0xea, 0xdd, 0x6a, 0xb3, 0x51,
+	0xaf, 0xce, 0x5c, 0x88, 0x00, 0x7c, 0x2f, 0x42, 0x22, 0x55, 0x94, 0x7a, 0x17, 0x4a, 0x94, 0x93,
+	0x92, 0x80, 0x84, 0x72, 0x17, 0x8a, 0x33, 0xae, 0x80, 0xbf, 0x07, 0x25, 0xff, 0x86, 0xb2, 0x48,
+	0xab, 0x15, 0x98, 0x75, 0x0e, 0x7e, 0x57, 0xab, 0xcb, 0xcd, 0xc6, 0x5e, 0xa3, 0xc7, 0xf4, 0xbb,
+	0x03, 0x30, 0xdd, 0x63, 0x9a, 0xb3, 0x76, 0xbb, 0xed, 0x96, 0xba, 0x4d, 0x2f, 0x7a, 0xbd, 0x88,
+	0xa8, 0x2c, 0xf0, 0x3d, 0x45, 0x02, 0xbd, 0x8f, 0xcc, 0x6f, 0x3c, 0x12, 0xaf, 0x7e, 0x25, 0xd0,
+	0x94, 0xf5, 0x55, 0x6b, 0xed, 0x27, 0x02, 0x7e, 0x4d, 0x7a, 0x9a, 0xc1, 0x99, 0x8a, 0x7d, 0x50,
+	0xd1, 0x6d, 0x7b, 0xad, 0x48, 0x7f, 0xd4, 0x6c, 0x7b, 0x3b, 0x48, 0xc4, 0x17, 0xa4, 0xdf, 0x64,
+	0xb0, 0x44, 0xa1, 0xf4, 0x11, 0x60, 0x0d, 0xd1, 0x5f, 0xbb, 0xda, 0x43, 0x2d, 0x24, 0x38, 0x2f,
+	0xfd, 0x57, 0x06, 0xa7, 0x29, 0x78, 0x68, 0xad, 0x15, 0xe8, 0xdf, 0x1d, 0x2b, 0x44, 0x5e, 0x94,
+	0xfe, 0xa4, 0x85, 0x81, 0x02, 0x99, 0xc7, 0xbe, 0xbf, 0x86, 0xe9, 0x6f, 0xf6, 0x6c, 0xf6, 0x7e,
+	0x40, 0x74, 0x35, 0x2d, 0x7d, 0xd5, 0x42, 0x3f, 0x6f, 0x5d, 0x4d, 0x4b, 0x3f, 0x6f, 0xa1, 0x5f,
+	0xb4, 0x76, 0xd3, 0xd2, 0x93, 0x0c, 0x7a, 0x9a, 0x29, 0xff, 0x4f, 0x02, 0xf0, 0xd4, 0xbf, 0xc3,
+	0xce, 0xcb, 0x3d, 0x90, 0xc2, 0x56, 0x0e, 0xff, 0x56, 0xf4, 0x07, 0xcf, 0x38, 0x16, 0x01, 0x5b,
+	0x04, 0x34, 0xd3, 0xda, 0x09, 0xa5, 0xd1, 0x7b, 0xfb, 0xd8, 0x30, 0x8d, 0xf1, 0x64, 0xac, 0x06,
+	0xfd, 0x8d, 0x13, 0xef, 0xed, 0x3e, 0x83, 0x3f, 0x66, 0x22, 0xb4, 0xc7, 0x31, 0x11, 0xa9, 0x13,
+	0x45, 0x70, 0x06, 0x7f, 0xbc, 0xf6, 0x5b, 0x01, 0x4a, 0xc7, 0x29, 0xfb, 0x42, 0xad, 0x97, 0x16,
+	0xac, 0x5a, 0x0f, 0x89, 0xe3, 0x18, 0x7d, 0xf6, 0x9a, 0x12, 0x16, 0x64, 0xc9, 0x93, 0x0b, 0xb2,
+	0x95, 0x08, 0x63, 0xb8, 0xa9, 0x5b, 0x34, 0x6f, 0x3e, 0xa6, 0x29, 0x23, 0x90, 0x94, 0x3a, 0x59,
+	0xd2, 0x12, 0x63, 0x09, 0x64, 0xec, 0xd2, 0x63, 0x42, 0xef, 0x40, 0x22, 0x4a, 0x4c, 0xab, 0xbe,
+	0xf2, 0x37, 0x22, 0x14, 0xe2, 0x1f, 0x67, 0xe2, 0x3a, 0x48, 0x23, 0xcb, 0xff, 0xf0, 0x89, 0xef,
+	0xf6, 0xc6, 0x09, 0xdf, 0x73, 0x56, 0x9a, 0x3e, 0xbd, 0x12, 0x72, 0xae, 0xfd, 0x8b, 0x00, 0x52,
+	0x00, 0xc6, 0x67, 0x21, 0x69, 0x6b, 0xde, 0x21, 0x13, 0x97, 0xda, 0x12, 0x91, 0xa0, 0xb0, 0x31,
+	0x85, 0xbb, 0xb6, 0xc6, 0x3f, 0xfa, 0xf2, 0xe1, 0x74, 0x4c, 0x2b, 0xaf, 0x11, 0xd1, 0xfa, 0xec,
+	0x1d, 0xd0, 0x1a, 0x8f, 0x89, 0xe9, 0xb9, 0x41, 0xe5, 0xe5, 0xc3, 0x6b, 0x3e, 0x98, 0x7e, 0x23,
+	0xec, 0x39, 0x9a, 0x31, 0x8a, 0xd1, 0x26, 0x19, 0x2d, 0x0a, 0x10, 0x21, 0xf1, 0x6d, 0x78, 0x35,
+	0x90, 0xdb, 0x27, 0x9e, 0xa6, 0x1f, 0x92, 0xfe, 0x94, 0x29, 0xcd, 0xde, 0xfb, 0xcf, 0xf9, 0x04,
+	0x75, 0x1f, 0x1f, 0xf0, 0x96, 0xff, 0x55, 0x84, 0xe5, 0xe0, 0xe5, 0xb2, 0x1f, 0x1a, 0x6b, 0x0f,
+	0x40, 0x33, 0x4d, 0xcb, 0x8b, 0x9a, 0x6b, 0xbe, 0xd8, 0x9c, 0xe3, 0xab, 0x54, 0x43, 0x26, 0x25,
+	0x22, 0x60, 0xed, 0xbf, 0x05, 0x80, 0x29, 0xea, 0x58, 0xbb, 0x5d, 0x80, 0x9c, 0xff, 0xe9, 0x2d,
+	0xfb, 0x7e, 0x9b, 0x37, 0xf8, 0x80, 0x83, 0xe8, 0x1b, 0x27, 0xed, 0xfd, 0x1d, 0x90, 0xa1, 0x61,
+	0xfa, 0xdf, 0x52, 0xf1, 0x41, 0xf0, 0x49, 0x42, 0x72, 0xfa, 0xad, 0xa1, 0x02, 0x92, 0x4b, 0xc6,
+	0x9a, 0xe9, 0x19, 0xba, 0x7f, 0x6a, 0x6e, 0x3c, 0x97, 0xf2, 0x95, 0xae, 0xcf, 0xad, 0x84, 0x72,
+	0xca, 0x1b, 0x20, 0x05, 0xd0, 0x30, 0x4a, 0xbf, 0x82, 0x33, 0x90, 0xe8, 0xca, 0x34, 0x4f, 0xb1,
+	0x60, 0xd9, 0xa8, 0x76, 0x91, 0x78, 0xf5, 0x1b, 0x11, 0x32, 0xc1, 0x31, 0x5e, 0x81, 0xa2, 0x5c,
+	0x6f, 0xcc, 0x04, 0xfc, 0x15, 0x28, 0x04, 0x40, 0x1e, 0x55, 0xd1, 0x1f, 0x67, 0xa2, 0xc0, 0x8e,
+	0xd2, 0xee, 0xb5, 0x37, 0xd1, 0xb7, 0xf3, 0xc0, 0x6b, 0xe8, 0x49, 0x06, 0x2f, 0x43, 0x3e, 0x00,
+	0x6e, 0xbe, 0xb7, 0x79, 0x0d, 0x3d, 0x9d, 0x05, 0x5d, 0x47, 0xbf, 0x61, 0xbd, 0xa5, 0x00, 0xf4,
+	0xbe, 0xda, 0xa3, 0x51, 0xbb, 0xdd, 0x6a, 0xde, 0x47, 0x42, 0x14, 0xb1, 0x19, 0x41, 0x88, 0xf8,
+	0x35, 0x38, 0x17, 0x20, 0x6e, 0xdd, 0xba, 0x75, 0xeb, 0xc3, 0x08, 0xf2, 0x97, 0x3f, 0x4b, 0xcf,
+	0xa2, 0x6f, 0x46, 0xd0, 0x7f, 0x3d, 0x8f, 0xbe, 0x15, 0x41, 0xff, 0xcd, 0xcf, 0xd2, 0x78, 0x05,
+	0x72, 0x01, 0x7a, 0xaf, 0x7a, 0x0f, 0x7d, 0xf7, 0xdd, 0x77, 0xdf, 0x65, 0xb6, 0xbe, 0x80, 0x15,
+	0xdd, 0x1a, 0xcf, 0x6e, 0xcd, 0x16, 0x9a, 0xf9, 0x30, 0xc2, 0xbd, 0x23, 0x7c, 0xfa, 0x8e, 0x4f,
+	0x34, 0xb4, 0x46, 0x9a, 0x39, 0xac, 0x58, 0xce, 0x70, 0xfa, 0x7f, 0x02, 0xb4, 0xc8, 0x75, 0x23,
+	0xff, 0x2d, 0x60, 0x1f, 0xfc, 0x56, 0x10, 0x7e, 0x25, 0x26, 0x76, 0x3a, 0x5b, 0x7f, 0x2b, 0xae,
+	0xed, 0x70, 0xc6, 0x4e, 0xb0, 0xf1, 0x0a, 0x19, 0x8c, 0x88, 0x4e, 0x77, 0xe7, 0xff, 0x06, 0x00,
+	0xee, 0xf7, 0xac, 0x87, 0x72, 0x30, 0x00, 0x00,
 }
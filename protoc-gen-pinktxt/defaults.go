@@ -0,0 +1,492 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+)
+
+func mergeDefaults(funcs template.FuncMap, types typeFinder, maps *TypeMaps) template.FuncMap {
+	if funcs == nil {
+		funcs = make(template.FuncMap)
+	}
+
+	funcs["default_value"] = types.defaultValue
+	funcs["has_default"] = func(field *desc.FieldDescriptorProto) bool { return field.DefaultValue != nil }
+	funcs["default_literal"] = func(lang string, field *desc.FieldDescriptorProto) (string, error) {
+		m, err := maps.Get(lang)
+		if err != nil {
+			return "", err
+		}
+		return types.defaultLiteral(m, field)
+	}
+	return funcs
+}
+
+// defaultValue returns the default value of field. Explicit defaults are
+// parsed from the field's default_value; otherwise the field type's zero
+// value is returned. Numbers are returned as their Go equivalents, bytes as
+// []byte, and enums as the name of their value. Message and repeated fields
+// have no default and return nil.
+func (t typeFinder) defaultValue(field *desc.FieldDescriptorProto) (interface{}, error) {
+	if isRepeated(field) {
+		return nil, nil
+	}
+
+	if field.GetType() == desc.FieldDescriptorProto_TYPE_ENUM {
+		if field.DefaultValue != nil {
+			return field.GetDefaultValue(), nil
+		}
+		e, ok := t.Find(field.GetTypeName()).(*desc.EnumDescriptorProto)
+		if !ok || len(e.GetValue()) == 0 {
+			return nil, fmt.Errorf("default_value %s: enum %s not found", field.GetName(), field.GetTypeName())
+		}
+		// The first value is the default in proto2 and is required to be
+		// zero in proto3.
+		return e.GetValue()[0].GetName(), nil
+	}
+
+	v, err := parseDefault(field.GetType(), field.GetDefaultValue(), field.DefaultValue != nil)
+	if err != nil {
+		return nil, fmt.Errorf("default_value %s: %v", field.GetName(), err)
+	}
+	return v, nil
+}
+
+// parseDefault parses the default value s, as encoded by protoc, of a scalar
+// type. If set is false, the type's zero value is returned.
+func parseDefault(typ desc.FieldDescriptorProto_Type, s string, set bool) (interface{}, error) {
+	if !set {
+		s = ""
+	}
+
+	switch typ {
+	case desc.FieldDescriptorProto_TYPE_DOUBLE, desc.FieldDescriptorProto_TYPE_FLOAT:
+		bits := 64
+		if typ == desc.FieldDescriptorProto_TYPE_FLOAT {
+			bits = 32
+		}
+
+		var f float64
+		switch s {
+		case "":
+		case "inf":
+			f = math.Inf(1)
+		case "-inf":
+			f = math.Inf(-1)
+		case "nan":
+			f = math.NaN()
+		default:
+			var err error
+			if f, err = strconv.ParseFloat(s, bits); err != nil {
+				return nil, err
+			}
+		}
+		if bits == 32 {
+			return float32(f), nil
+		}
+		return f, nil
+
+	case desc.FieldDescriptorProto_TYPE_INT32, desc.FieldDescriptorProto_TYPE_SINT32, desc.FieldDescriptorProto_TYPE_SFIXED32:
+		i, err := parseInt(s, 32)
+		return int32(i), err
+	case desc.FieldDescriptorProto_TYPE_INT64, desc.FieldDescriptorProto_TYPE_SINT64, desc.FieldDescriptorProto_TYPE_SFIXED64:
+		return parseInt(s, 64)
+	case desc.FieldDescriptorProto_TYPE_UINT32, desc.FieldDescriptorProto_TYPE_FIXED32:
+		u, err := parseUint(s, 32)
+		return uint32(u), err
+	case desc.FieldDescriptorProto_TYPE_UINT64, desc.FieldDescriptorProto_TYPE_FIXED64:
+		return parseUint(s, 64)
+
+	case desc.FieldDescriptorProto_TYPE_BOOL:
+		return s == "true", nil
+	case desc.FieldDescriptorProto_TYPE_STRING:
+		return s, nil
+	case desc.FieldDescriptorProto_TYPE_BYTES:
+		return unescapeBytes(s)
+
+	case desc.FieldDescriptorProto_TYPE_MESSAGE, desc.FieldDescriptorProto_TYPE_GROUP:
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %v", typ)
+}
+
+func parseInt(s string, bits int) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(s, 0, bits)
+}
+
+func parseUint(s string, bits int) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(s, 0, bits)
+}
+
+// unescapeBytes decodes the C-style escaping protoc uses for bytes defaults.
+func unescapeBytes(s string) ([]byte, error) {
+	out := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' {
+			out = append(out, c)
+			continue
+		}
+
+		i++
+		if i >= len(s) {
+			return nil, fmt.Errorf("invalid escape at end of %q", s)
+		}
+
+		switch c = s[i]; c {
+		case 'a':
+			out = append(out, '\a')
+		case 'b':
+			out = append(out, '\b')
+		case 'f':
+			out = append(out, '\f')
+		case 'n':
+			out = append(out, '\n')
+		case 'r':
+			out = append(out, '\r')
+		case 't':
+			out = append(out, '\t')
+		case 'v':
+			out = append(out, '\v')
+		case '\\', '\'', '"', '?':
+			out = append(out, c)
+		case 'x', 'X':
+			j := i + 1
+			for ; j < len(s) && j < i+3 && isHexDigit(s[j]); j++ {
+			}
+			if j == i+1 {
+				return nil, fmt.Errorf("invalid hex escape in %q", s)
+			}
+			v, _ := strconv.ParseUint(s[i+1:j], 16, 8)
+			out = append(out, byte(v))
+			i = j - 1
+		default:
+			if c < '0' || c > '7' {
+				return nil, fmt.Errorf("invalid escape \\%c in %q", c, s)
+			}
+			j := i
+			for ; j < len(s) && j < i+3 && s[j] >= '0' && s[j] <= '7'; j++ {
+			}
+			v, err := strconv.ParseUint(s[i:j], 8, 8)
+			if err != nil {
+				return nil, fmt.Errorf("invalid octal escape in %q", s)
+			}
+			out = append(out, byte(v))
+			i = j - 1
+		}
+	}
+	return out, nil
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+// defaultLiteral returns the default value of field as a literal in the
+// language of type map m. Type maps loaded from files use the literals of
+// their base type map.
+func (t typeFinder) defaultLiteral(m *TypeMap, field *desc.FieldDescriptorProto) (string, error) {
+	lang := m.literals()
+	if lang == "" {
+		return "", fmt.Errorf("default_literal: type map %s has no literal syntax", m.Name)
+	}
+
+	v, err := t.defaultValue(field)
+	if err != nil {
+		return "", err
+	}
+
+	switch v := v.(type) {
+	case nil:
+		return nullLiterals[lang], nil
+	case bool:
+		if lang == "python" {
+			return strings.Title(strconv.FormatBool(v)), nil
+		}
+		return strconv.FormatBool(v), nil
+	case string:
+		if isEnum(field) {
+			return t.enumLiteral(m, lang, field, v)
+		}
+		return quoteString(lang, v), nil
+	case []byte:
+		return bytesLiteral(lang, v), nil
+	case float32:
+		return floatLiteral(lang, float64(v), 32), nil
+	case float64:
+		return floatLiteral(lang, v, 64), nil
+	}
+
+	// Integers.
+	s := fmt.Sprint(v)
+	switch lang {
+	case "java", "kotlin":
+		s = jvmIntLiteral(lang, v)
+	case "csharp":
+		switch v.(type) {
+		case int64:
+			s += "L"
+		case uint64:
+			s += "UL"
+		case uint32:
+			s += "U"
+		}
+	case "typescript":
+		switch v.(type) {
+		case int64, uint64:
+			s += "n"
+		}
+	}
+	return s, nil
+}
+
+// jvmIntLiteral returns the Java or Kotlin literal for the integer v.
+// Unsigned types are represented by signed ones of the same size. The
+// minimum values are named, since Kotlin can't write them as literals.
+func jvmIntLiteral(lang string, v interface{}) string {
+	var i int64
+	long := false
+	switch v := v.(type) {
+	case int32:
+		i = int64(v)
+	case uint32:
+		i = int64(int32(v))
+	case int64:
+		i, long = v, true
+	case uint64:
+		i, long = int64(v), true
+	}
+
+	switch {
+	case long && i == math.MinInt64:
+		return "Long.MIN_VALUE"
+	case long:
+		return strconv.FormatInt(i, 10) + "L"
+	case i == math.MinInt32 && lang == "java":
+		return "Integer.MIN_VALUE"
+	case i == math.MinInt32:
+		return "Int.MIN_VALUE"
+	}
+	return strconv.FormatInt(i, 10)
+}
+
+var nullLiterals = map[string]string{
+	"go":         "nil",
+	"typescript": "undefined",
+	"python":     "None",
+	"java":       "null",
+	"rust":       "None",
+	"csharp":     "null",
+	"swift":      "nil",
+	"kotlin":     "null",
+}
+
+// literals returns the name of the built-in type map whose literal syntax m
+// uses.
+func (m *TypeMap) literals() string {
+	if _, ok := builtinTypeMaps[m.Name]; ok {
+		return m.Name
+	}
+	return m.base
+}
+
+func (t typeFinder) enumLiteral(m *TypeMap, lang string, field *desc.FieldDescriptorProto, value string) (string, error) {
+	typ, err := t.qualifiedType(m, field)
+	if err != nil {
+		return "", err
+	}
+
+	switch lang {
+	case "go":
+		// Go prefixes values with the enum's parent rather than the enum.
+		if e, ok := t.Find(field.GetTypeName()).(*desc.EnumDescriptorProto); ok {
+			if parent, nested := t.parent(e).(*desc.DescriptorProto); nested {
+				if typ, err = t.qualifiedName(m, t.fullName(parent), t.packageOf(field)); err != nil {
+					return "", err
+				}
+			}
+		}
+		return typ + "_" + value, nil
+	case "rust":
+		return typ + "::" + ToPascalCase(value), nil
+	case "swift":
+		return typ + "." + ToCamelCase(value), nil
+	}
+	return typ + "." + value, nil
+}
+
+func quoteString(lang, s string) string {
+	if lang == "go" {
+		return strconv.Quote(s)
+	}
+
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"', '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '$':
+			if lang == "kotlin" {
+				b.WriteByte('\\')
+			}
+			b.WriteRune(r)
+		default:
+			if r < 0x20 || r == 0x7f || r == utf8.RuneError {
+				switch lang {
+				case "rust", "swift":
+					fmt.Fprintf(&b, `\u{%x}`, r)
+				default:
+					fmt.Fprintf(&b, `\u%04x`, r)
+				}
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func bytesLiteral(lang string, p []byte) string {
+	list := func(conv func(byte) string) string {
+		elems := make([]string, len(p))
+		for i, c := range p {
+			elems[i] = conv(c)
+		}
+		return strings.Join(elems, ", ")
+	}
+	unsigned := func(c byte) string { return strconv.Itoa(int(c)) }
+	signed := func(c byte) string { return strconv.Itoa(int(int8(c))) }
+
+	switch lang {
+	case "go":
+		return "[]byte(" + strconv.Quote(string(p)) + ")"
+	case "python":
+		var b strings.Builder
+		b.WriteString(`b"`)
+		for _, c := range p {
+			if c >= 0x20 && c < 0x7f && c != '"' && c != '\\' {
+				b.WriteByte(c)
+			} else {
+				fmt.Fprintf(&b, `\x%02x`, c)
+			}
+		}
+		b.WriteByte('"')
+		return b.String()
+	case "typescript":
+		return "new Uint8Array([" + list(unsigned) + "])"
+	case "java":
+		return "com.google.protobuf.ByteString.copyFrom(new byte[] {" + list(signed) + "})"
+	case "kotlin":
+		return "com.google.protobuf.ByteString.copyFrom(byteArrayOf(" + list(signed) + "))"
+	case "rust":
+		return "vec![" + list(unsigned) + "]"
+	case "csharp":
+		if len(p) == 0 {
+			return "Google.Protobuf.ByteString.Empty"
+		}
+		return "Google.Protobuf.ByteString.CopyFrom(new byte[] { " + list(unsigned) + " })"
+	case "swift":
+		return "Data([" + list(unsigned) + "])"
+	}
+
+	b, _ := json.Marshal(p)
+	return string(b)
+}
+
+func floatLiteral(lang string, f float64, bits int) string {
+	var inf, nan string
+	switch lang {
+	case "go":
+		inf, nan = "math.Inf(%d)", "math.NaN()"
+		if bits == 32 {
+			inf, nan = "float32(math.Inf(%d))", "float32(math.NaN())"
+		}
+	case "typescript":
+		inf, nan = "%sInfinity", "NaN"
+	case "python":
+		inf, nan = `float("%sinf")`, `float("nan")`
+	case "java", "kotlin":
+		inf, nan = "Double.%s_INFINITY", "Double.NaN"
+		if bits == 32 {
+			inf, nan = "Float.%s_INFINITY", "Float.NaN"
+		}
+	case "rust":
+		inf, nan = "%sf64::INFINITY", "f64::NAN"
+		if bits == 32 {
+			inf, nan = "%sf32::INFINITY", "f32::NAN"
+		}
+	case "csharp":
+		inf, nan = "double.%sInfinity", "double.NaN"
+		if bits == 32 {
+			inf, nan = "float.%sInfinity", "float.NaN"
+		}
+	case "swift":
+		inf, nan = "%sDouble.infinity", "Double.nan"
+		if bits == 32 {
+			inf, nan = "%sFloat.infinity", "Float.nan"
+		}
+	}
+
+	switch {
+	case math.IsNaN(f):
+		return nan
+	case math.IsInf(f, 0):
+		sign := 1
+		if f < 0 {
+			sign = -1
+		}
+		switch lang {
+		case "go":
+			return fmt.Sprintf(inf, sign)
+		case "java", "kotlin":
+			if sign < 0 {
+				return fmt.Sprintf(inf, "NEGATIVE")
+			}
+			return fmt.Sprintf(inf, "POSITIVE")
+		case "csharp":
+			if sign < 0 {
+				return fmt.Sprintf(inf, "Negative")
+			}
+			return fmt.Sprintf(inf, "Positive")
+		}
+		if sign < 0 {
+			return fmt.Sprintf(inf, "-")
+		}
+		return fmt.Sprintf(inf, "")
+	}
+
+	s := strconv.FormatFloat(f, 'g', -1, bits)
+	if !strings.ContainsAny(s, ".eEn") {
+		s += ".0"
+	}
+	if bits == 32 {
+		switch lang {
+		case "java", "kotlin", "csharp":
+			s += "f"
+		}
+	}
+	return s
+}
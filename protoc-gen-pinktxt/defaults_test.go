@@ -0,0 +1,249 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+	compiler "github.com/nilium/pinktxt/internal/plugin/google/protobuf/compiler"
+)
+
+func TestUnescapeBytes(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []byte
+	}{
+		{``, []byte{}},
+		{`abc`, []byte("abc")},
+		{`\a\b\f\n\r\t\v`, []byte("\a\b\f\n\r\t\v")},
+		{`\\\'\"\?`, []byte(`\'"?`)},
+		{`\0`, []byte{0}},
+		{`\01`, []byte{1}},
+		{`\001\377`, []byte{1, 0xff}},
+		// Octal escapes are at most three digits long.
+		{`\1234`, []byte{0123, '4'}},
+		{`\08`, []byte{0, '8'}},
+		{`\x1`, []byte{1}},
+		{`\x41B`, []byte{0x41, 'B'}},
+		// Hex escapes are at most two digits long.
+		{`\xfff`, []byte{0xff, 'f'}},
+		{`\XaB`, []byte{0xab}},
+		{`a\x00b`, []byte{'a', 0, 'b'}},
+	}
+
+	for _, c := range cases {
+		got, err := unescapeBytes(c.in)
+		if err != nil {
+			t.Errorf("unescapeBytes(%q) error = %v", c.in, err)
+		} else if !bytes.Equal(got, c.want) {
+			t.Errorf("unescapeBytes(%q) = %q; want %q", c.in, got, c.want)
+		}
+	}
+
+	for _, in := range []string{
+		`\`,
+		`abc\`,
+		`\x`,
+		`\xg`,
+		`\8`,
+		`\q`,
+		`\400`,
+	} {
+		if got, err := unescapeBytes(in); err == nil {
+			t.Errorf("unescapeBytes(%q) = %q; want error", in, got)
+		}
+	}
+}
+
+func testDefaultField(typ desc.FieldDescriptorProto_Type, def string) *desc.FieldDescriptorProto {
+	f := &desc.FieldDescriptorProto{
+		Name:   proto.String("f"),
+		Number: proto.Int32(1),
+		Label:  desc.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:   typ.Enum(),
+	}
+	if def != "" {
+		f.DefaultValue = proto.String(def)
+	}
+	return f
+}
+
+func TestDefaultLiteral(t *testing.T) {
+	const (
+		tBool   = desc.FieldDescriptorProto_TYPE_BOOL
+		tInt32  = desc.FieldDescriptorProto_TYPE_INT32
+		tInt64  = desc.FieldDescriptorProto_TYPE_INT64
+		tUint32 = desc.FieldDescriptorProto_TYPE_UINT32
+		tUint64 = desc.FieldDescriptorProto_TYPE_UINT64
+		tFloat  = desc.FieldDescriptorProto_TYPE_FLOAT
+		tDouble = desc.FieldDescriptorProto_TYPE_DOUBLE
+		tString = desc.FieldDescriptorProto_TYPE_STRING
+		tBytes  = desc.FieldDescriptorProto_TYPE_BYTES
+	)
+
+	type lits map[string]string
+	cases := []struct {
+		typ  desc.FieldDescriptorProto_Type
+		def  string
+		want lits
+	}{
+		{tBool, "true", lits{"go": "true", "python": "True", "java": "true"}},
+		{tBool, "", lits{"go": "false", "python": "False"}},
+		{tInt32, "-2147483648", lits{
+			"go":     "-2147483648",
+			"java":   "Integer.MIN_VALUE",
+			"kotlin": "Int.MIN_VALUE",
+			"csharp": "-2147483648",
+		}},
+		{tInt32, "42", lits{"go": "42", "java": "42", "kotlin": "42", "typescript": "42"}},
+		{tInt64, "-9223372036854775808", lits{
+			"go":         "-9223372036854775808",
+			"java":       "Long.MIN_VALUE",
+			"kotlin":     "Long.MIN_VALUE",
+			"csharp":     "-9223372036854775808L",
+			"typescript": "-9223372036854775808n",
+		}},
+		{tInt64, "7", lits{"java": "7L", "kotlin": "7L", "csharp": "7L", "typescript": "7n", "rust": "7"}},
+		{tUint32, "4294967295", lits{"go": "4294967295", "java": "-1", "kotlin": "-1", "csharp": "4294967295U"}},
+		{tUint32, "2147483648", lits{"java": "Integer.MIN_VALUE", "kotlin": "Int.MIN_VALUE"}},
+		{tUint64, "18446744073709551615", lits{
+			"go":     "18446744073709551615",
+			"java":   "-1L",
+			"kotlin": "-1L",
+			"csharp": "18446744073709551615UL",
+		}},
+		{tUint64, "9223372036854775808", lits{"java": "Long.MIN_VALUE", "kotlin": "Long.MIN_VALUE"}},
+		{tFloat, "-inf", lits{
+			"go":         "float32(math.Inf(-1))",
+			"java":       "Float.NEGATIVE_INFINITY",
+			"kotlin":     "Float.NEGATIVE_INFINITY",
+			"csharp":     "float.NegativeInfinity",
+			"rust":       "-f32::INFINITY",
+			"swift":      "-Float.infinity",
+			"python":     `float("-inf")`,
+			"typescript": "-Infinity",
+		}},
+		{tFloat, "inf", lits{"go": "float32(math.Inf(1))", "java": "Float.POSITIVE_INFINITY"}},
+		{tFloat, "nan", lits{"go": "float32(math.NaN())", "java": "Float.NaN", "rust": "f32::NAN", "typescript": "NaN"}},
+		{tFloat, "1.5", lits{"go": "1.5", "java": "1.5f", "kotlin": "1.5f", "csharp": "1.5f", "rust": "1.5"}},
+		{tFloat, "2", lits{"go": "2.0", "java": "2.0f"}},
+		{tDouble, "-inf", lits{"go": "math.Inf(-1)", "java": "Double.NEGATIVE_INFINITY", "csharp": "double.NegativeInfinity"}},
+		{tDouble, "nan", lits{"go": "math.NaN()", "swift": "Double.nan", "python": `float("nan")`}},
+		{tDouble, "1e+100", lits{"go": "1e+100", "java": "1e+100"}},
+		{tString, `a"b$`, lits{
+			"go":     `"a\"b$"`,
+			"java":   `"a\"b$"`,
+			"kotlin": `"a\"b\$"`,
+		}},
+		{tString, "\x01\n", lits{"go": `"\x01\n"`, "java": `"\u0001\n"`, "rust": `"\u{1}\n"`}},
+		{tBytes, `\001\377`, lits{
+			"go":         `[]byte("\x01\xff")`,
+			"python":     `b"\x01\xff"`,
+			"typescript": "new Uint8Array([1, 255])",
+			"java":       "com.google.protobuf.ByteString.copyFrom(new byte[] {1, -1})",
+			"kotlin":     "com.google.protobuf.ByteString.copyFrom(byteArrayOf(1, -1))",
+			"rust":       "vec![1, 255]",
+			"csharp":     "Google.Protobuf.ByteString.CopyFrom(new byte[] { 1, 255 })",
+			"swift":      "Data([1, 255])",
+		}},
+		{tBytes, "", lits{"csharp": "Google.Protobuf.ByteString.Empty", "go": `[]byte("")`}},
+	}
+
+	finder := newTypeFinder(&compiler.CodeGeneratorRequest{})
+	for _, c := range cases {
+		field := testDefaultField(c.typ, c.def)
+		for lang, want := range c.want {
+			got, err := finder.defaultLiteral(builtinTypeMaps[lang], field)
+			if err != nil {
+				t.Errorf("defaultLiteral(%s, %v %q) error = %v", lang, c.typ, c.def, err)
+			} else if got != want {
+				t.Errorf("defaultLiteral(%s, %v %q) = %s; want %s", lang, c.typ, c.def, got, want)
+			}
+		}
+	}
+}
+
+func TestDefaultLiteralNull(t *testing.T) {
+	field := testDefaultField(desc.FieldDescriptorProto_TYPE_MESSAGE, "")
+	finder := newTypeFinder(&compiler.CodeGeneratorRequest{})
+	for lang, want := range nullLiterals {
+		got, err := finder.defaultLiteral(builtinTypeMaps[lang], field)
+		if err != nil || got != want {
+			t.Errorf("defaultLiteral(%s, message) = %q, %v; want %q", lang, got, err, want)
+		}
+	}
+}
+
+func TestDefaultLiteralEnum(t *testing.T) {
+	enumField := func(name, typeName, def string) *desc.FieldDescriptorProto {
+		f := testDefaultField(desc.FieldDescriptorProto_TYPE_ENUM, def)
+		f.Name = proto.String(name)
+		f.TypeName = proto.String(typeName)
+		return f
+	}
+	enum := func(name, value string) *desc.EnumDescriptorProto {
+		return &desc.EnumDescriptorProto{
+			Name:  proto.String(name),
+			Value: []*desc.EnumValueDescriptorProto{{Name: proto.String(value), Number: proto.Int32(0)}},
+		}
+	}
+
+	nested := enumField("nested", ".pkg.Outer.My_Enum", "V")
+	deep := enumField("deep", ".pkg.Outer.Inner_Msg.Deep_Enum", "D")
+	top := enumField("top", ".pkg.Top_Level", "T")
+	remote := enumField("remote", ".pkg.Outer.My_Enum", "V")
+
+	req := &compiler.CodeGeneratorRequest{
+		ProtoFile: []*desc.FileDescriptorProto{
+			{
+				Name:     proto.String("pkg.proto"),
+				Package:  proto.String("pkg"),
+				EnumType: []*desc.EnumDescriptorProto{enum("Top_Level", "T")},
+				MessageType: []*desc.DescriptorProto{
+					{
+						Name:     proto.String("Outer"),
+						EnumType: []*desc.EnumDescriptorProto{enum("My_Enum", "V")},
+						NestedType: []*desc.DescriptorProto{
+							{
+								Name:     proto.String("Inner_Msg"),
+								EnumType: []*desc.EnumDescriptorProto{enum("Deep_Enum", "D")},
+							},
+						},
+					},
+					{Name: proto.String("Holder"), Field: []*desc.FieldDescriptorProto{nested, deep, top}},
+				},
+			},
+			{
+				Name:        proto.String("other.proto"),
+				Package:     proto.String("a.other"),
+				MessageType: []*desc.DescriptorProto{{Name: proto.String("Remote"), Field: []*desc.FieldDescriptorProto{remote}}},
+			},
+		},
+	}
+
+	cases := []struct {
+		field      *desc.FieldDescriptorProto
+		lang, want string
+	}{
+		{nested, "go", "Outer_V"},
+		{deep, "go", "Outer_Inner_Msg_D"},
+		{top, "go", "Top_Level_T"},
+		{remote, "go", "pkg.Outer_V"},
+		{nested, "java", "Outer.My_Enum.V"},
+		{remote, "java", "pkg.Outer.My_Enum.V"},
+		{nested, "rust", "Outer::My_Enum::V"},
+		{top, "swift", "Top_Level.t"},
+	}
+
+	finder := newTypeFinder(req)
+	for _, c := range cases {
+		got, err := finder.defaultLiteral(builtinTypeMaps[c.lang], c.field)
+		if err != nil {
+			t.Errorf("defaultLiteral(%s, %s) error = %v", c.lang, c.field.GetName(), err)
+		} else if got != c.want {
+			t.Errorf("defaultLiteral(%s, %s) = %s; want %s", c.lang, c.field.GetName(), got, c.want)
+		}
+	}
+}
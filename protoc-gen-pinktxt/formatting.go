@@ -105,36 +105,9 @@ var defaultTemplateFuncs = template.FuncMap{
 		return strings.Replace(s, old, new, count)
 	},
 
-	"log":   func(d ...interface{}) error { log.Print(d...); return nil },
-	"logln": func(d ...interface{}) error { log.Println(d...); return nil },
-	"logf":  func(f string, d ...interface{}) error { log.Printf(f, d...); return nil },
-
-	"option": func(name string, pkg *desc.FileDescriptorProto) interface{} {
-		var bits []string
-		for _, v := range pkg.GetOptions().GetUninterpretedOption() {
-			bits = bits[0:0]
-			if strings.Join(bits, ".") != name {
-				continue
-			}
-			switch {
-			case v.IdentifierValue != nil:
-				return *v.IdentifierValue
-			case v.PositiveIntValue != nil:
-				return *v.PositiveIntValue
-			case v.NegativeIntValue != nil:
-				return *v.NegativeIntValue
-			case v.DoubleValue != nil:
-				return *v.DoubleValue
-			case v.AggregateValue != nil:
-				return *v.AggregateValue
-			case v.StringValue != nil:
-				return string(v.StringValue)
-			default:
-				return nil
-			}
-		}
-		return nil
-	},
+	"log":   func(d ...interface{}) (string, error) { log.Print(d...); return "", nil },
+	"logln": func(d ...interface{}) (string, error) { log.Println(d...); return "", nil },
+	"logf":  func(f string, d ...interface{}) (string, error) { log.Printf(f, d...); return "", nil },
 }
 
 func copyDefaultTemplateFuncs(dst template.FuncMap) template.FuncMap {
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"text/template"
+
+	compiler "github.com/nilium/pinktxt/internal/plugin/google/protobuf/compiler"
+)
+
+func mergeInsertions(funcs template.FuncMap, ins *insertions) template.FuncMap {
+	if funcs == nil {
+		funcs = make(template.FuncMap)
+	}
+
+	funcs["insert"] = ins.insert
+	funcs["insertion_point"] = insertionPoint
+	return funcs
+}
+
+type insertKey struct {
+	file, point string
+}
+
+// insertions holds content to be inserted at insertion points of files
+// generated by other plugins (or earlier in the same response).
+type insertions struct {
+	policy *outputPolicy
+	order  []insertKey
+	chunks map[insertKey]*bytes.Buffer
+}
+
+func newInsertions(policy *outputPolicy) *insertions {
+	return &insertions{
+		policy: policy,
+		chunks: make(map[insertKey]*bytes.Buffer),
+	}
+}
+
+// insert appends content to the chunks inserted at point in file. Chunks for
+// the same point are inserted in the order they were added. It is typically
+// used at the end of a pipeline:
+//
+//	(* exec "imports" . | insert "foo.pb.go" "imports" *)
+func (ins *insertions) insert(file, point, content string) (string, error) {
+	if file == "" {
+		return "", fmt.Errorf("insert: no file given for insertion point %q", point)
+	}
+	if point == "" {
+		return "", fmt.Errorf("insert: no insertion point given for %s", file)
+	}
+	file, err := ins.policy.check(file)
+	if err != nil {
+		return "", fmt.Errorf("insert %q: %v", point, err)
+	}
+
+	key := insertKey{file, point}
+	b, ok := ins.chunks[key]
+	if !ok {
+		b = &bytes.Buffer{}
+		ins.chunks[key] = b
+		ins.order = append(ins.order, key)
+	}
+	b.WriteString(content)
+	return "", nil
+}
+
+// files returns a response file for each insertion point written to, sorted
+// by file name. Insertion points of the same file are kept in the order they
+// were first written to.
+func (ins *insertions) files() []*compiler.CodeGeneratorResponse_File {
+	order := append([]insertKey(nil), ins.order...)
+	sort.SliceStable(order, func(i, j int) bool { return order[i].file < order[j].file })
+
+	out := make([]*compiler.CodeGeneratorResponse_File, 0, len(order))
+	for _, key := range order {
+		out = append(out, &compiler.CodeGeneratorResponse_File{
+			Name:           heapString(key.file),
+			InsertionPoint: heapString(key.point),
+			Content:        heapString(ins.chunks[key].String()),
+		})
+	}
+	return out
+}
+
+// insertionPoint returns the marker declaring an insertion point in
+// generated output. It should be written inside a comment on a line of its
+// own, e.g.:
+//
+//	// (* insertion_point "imports" *)
+func insertionPoint(name string) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("insertion_point: no name given")
+	}
+	return "@@protoc_insertion_point(" + name + ")", nil
+}
@@ -2,10 +2,12 @@ package main
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"text/template"
@@ -24,6 +26,16 @@ func heapString(s string) *string {
 	return &s
 }
 
+// supportedFeatures is the set of CodeGeneratorResponse features pinktxt
+// declares to protoc.
+const supportedFeatures = uint64(compiler.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL) |
+	uint64(compiler.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
+
+const (
+	minimumEdition = desc.Edition_EDITION_PROTO2
+	maximumEdition = desc.Edition_EDITION_2023
+)
+
 type Template interface {
 	Execute(io.Writer, string, interface{}) error
 	ExecuteTemplate(io.Writer, string, interface{}) error
@@ -118,117 +130,11 @@ func parseParameters(params string) Params {
 	return Params(r)
 }
 
-type typeFinder struct {
-	Request *compiler.CodeGeneratorRequest
-}
-
-func (t typeFinder) req() *compiler.CodeGeneratorRequest {
-	return t.Request
-}
-
-func (t typeFinder) findEnum(in []*desc.EnumDescriptorProto, name string) *desc.EnumDescriptorProto {
-	for _, e := range in {
-		if "."+e.GetName() == name {
-			return e
-		}
-	}
-	return nil
-}
-
-func (t typeFinder) findExtension(in []*desc.FieldDescriptorProto, name string) *desc.FieldDescriptorProto {
-	for _, e := range in {
-		if "."+e.GetName() == name {
-			return e
-		}
-	}
-	return nil
-}
-
-func (t typeFinder) findType(in *desc.DescriptorProto, name string) interface{} {
-	root := "." + in.GetName()
-	if root == name {
-		return in
-	}
-
-	prefix := root + "."
-	if !strings.HasPrefix(prefix, name) {
-		return nil
-	}
-
-	name = strings.TrimPrefix(name, root)
-	for _, in := range in.GetNestedType() {
-		if m := t.findType(in, name); m != nil {
-			return m
-		}
-	}
-
-	if e := t.findEnum(in.GetEnumType(), name); e != nil {
-		return e
-	}
-
-	if e := t.findEnum(in.GetEnumType(), name); e != nil {
-		return e
-	}
-
-	if e := t.findExtension(in.GetExtension(), name); e != nil {
-		return e
-	}
-
-	return nil
-}
-
-func (t typeFinder) Find(name string) interface{} {
-	if name == "" || name[0] != '.' {
-		return nil
-	}
-
-	req := t.req()
-	var pkg *desc.FileDescriptorProto
-	for _, p := range req.GetProtoFile() {
-		if p.Package == nil {
-			continue
-		}
-
-		root := "." + p.GetPackage()
-		prefix := root + "."
-
-		if strings.HasPrefix(name, prefix) {
-			pkg = p
-
-			break
-		}
-
-		if name == root {
-			return p
-		}
-	}
-
-	if pkg == nil {
-		return nil
-	}
-
-	for _, m := range pkg.GetMessageType() {
-		if found := t.findType(m, name); found != nil {
-			return found
-		}
-	}
-
-	if e := t.findEnum(pkg.GetEnumType(), name); e != nil {
-		return e
-	}
-
-	if e := t.findExtension(pkg.GetExtension(), name); e != nil {
-		return e
-	}
-
-	// TODO: Services
-	// TODO: Groups
-
-	return nil
-}
-
 type FlatTypeRoot struct {
-	Request   *compiler.CodeGeneratorRequest
+	Request *compiler.CodeGeneratorRequest
+	// File is the file being generated when running with per_file=true.
+	// Exported then contains only its types.
+	File      *desc.FileDescriptorProto
 	Visible   *FlatTypes
 	Exported  *FlatTypes
 	Params    Params
@@ -243,6 +149,30 @@ type FlatTypes struct {
 	Messages   map[string]*desc.DescriptorProto
 	Extensions map[string]*desc.FieldDescriptorProto
 	Services   map[string]*desc.ServiceDescriptorProto
+
+	// FileList, EnumList, MessageList, ExtensionList and ServiceList hold the
+	// same descriptors as the maps above in declaration order. Nested types
+	// follow the message they're declared in.
+	FileList      []*desc.FileDescriptorProto
+	EnumList      []*desc.EnumDescriptorProto
+	MessageList   []*desc.DescriptorProto
+	ExtensionList []*desc.FieldDescriptorProto
+	ServiceList   []*desc.ServiceDescriptorProto
+
+	// skipMapEntries excludes synthetic map entry messages from Messages.
+	// Their nested types, if any, are still included.
+	skipMapEntries bool
+}
+
+func newFlatTypes(mapEntries bool) *FlatTypes {
+	return &FlatTypes{
+		Files:          make(map[string]*desc.FileDescriptorProto),
+		Enums:          make(map[string]*desc.EnumDescriptorProto),
+		Messages:       make(map[string]*desc.DescriptorProto),
+		Extensions:     make(map[string]*desc.FieldDescriptorProto),
+		Services:       make(map[string]*desc.ServiceDescriptorProto),
+		skipMapEntries: !mapEntries,
+	}
 }
 
 func (f *FlatTypes) Package() interface{} {
@@ -259,7 +189,10 @@ func (f *FlatTypes) Package() interface{} {
 func (f *FlatTypes) populateMessageTypes(m []*desc.DescriptorProto, prefix string) {
 	for _, d := range m {
 		name := prefix + d.GetName()
-		f.Messages[name] = d
+		if !f.skipMapEntries || !d.GetOptions().GetMapEntry() {
+			f.Messages[name] = d
+			f.MessageList = append(f.MessageList, d)
+		}
 
 		prefix := name + "."
 		f.populateMessageTypes(d.GetNestedType(), prefix)
@@ -271,34 +204,32 @@ func (f *FlatTypes) populateMessageTypes(m []*desc.DescriptorProto, prefix strin
 func (f *FlatTypes) populateEnums(m []*desc.EnumDescriptorProto, prefix string) {
 	for _, e := range m {
 		f.Enums[prefix+e.GetName()] = e
+		f.EnumList = append(f.EnumList, e)
 	}
 }
 
 func (f *FlatTypes) populateExtensions(m []*desc.FieldDescriptorProto, prefix string) {
 	for _, e := range m {
 		f.Extensions[prefix+e.GetName()] = e
+		f.ExtensionList = append(f.ExtensionList, e)
 	}
 }
 
 func (f *FlatTypes) populateServices(m []*desc.ServiceDescriptorProto, prefix string) {
 	for _, e := range m {
 		f.Services[prefix+e.GetName()] = e
+		f.ServiceList = append(f.ServiceList, e)
 	}
 }
 
 func flatTypesForFile(pkg *desc.FileDescriptorProto, out *FlatTypes) *FlatTypes {
 	if out == nil {
-		out = &FlatTypes{
-			Files:      make(map[string]*desc.FileDescriptorProto),
-			Enums:      make(map[string]*desc.EnumDescriptorProto),
-			Messages:   make(map[string]*desc.DescriptorProto),
-			Extensions: make(map[string]*desc.FieldDescriptorProto),
-			Services:   make(map[string]*desc.ServiceDescriptorProto),
-		}
+		out = newFlatTypes(true)
 	}
 
 	out.File = pkg
 	out.Files[pkg.GetName()] = pkg
+	out.FileList = append(out.FileList, pkg)
 	prefix := "." + pkg.GetPackage() + "."
 	out.populateMessageTypes(pkg.GetMessageType(), prefix)
 	out.populateEnums(pkg.GetEnumType(), prefix)
@@ -336,7 +267,11 @@ func main() {
 	log.SetPrefix("pinktxt: ")
 	log.SetFlags(0)
 
-	var resp = new(compiler.CodeGeneratorResponse)
+	var resp = &compiler.CodeGeneratorResponse{
+		SupportedFeatures: proto.Uint64(supportedFeatures),
+		MinimumEdition:    proto.Int32(int32(minimumEdition)),
+		MaximumEdition:    proto.Int32(int32(maximumEdition)),
+	}
 	defer func() {
 		output, err := proto.Marshal(resp)
 		if err != nil {
@@ -380,11 +315,12 @@ func main() {
 	}
 
 	params := parseParameters(req.GetParameter())
-	files := make(map[string]*bytes.Buffer)
+	files := newOutputFiles(params.Get("header"), params.Get("footer"))
+	mapEntries := params.Bool("map_entries", true)
 	root := FlatTypeRoot{
 		Request:   &req,
-		Visible:   getFlatTypes(&req, false, nil),
-		Exported:  getFlatTypes(&req, true, nil),
+		Visible:   getFlatTypes(&req, false, newFlatTypes(mapEntries)),
+		Exported:  getFlatTypes(&req, true, newFlatTypes(mapEntries)),
 		Params:    params,
 		HasData:   false,
 		ExecParam: nil,
@@ -398,38 +334,97 @@ func main() {
 		right = p
 	}
 
+	typeMaps, err := loadTypeMaps(params.Get("typemap"), params["typemap_file"])
+	if err != nil {
+		resp.Error = heapString(err.Error())
+		return
+	}
+
+	policy, err := newOutputPolicy(params["allow_out"])
+	if err != nil {
+		resp.Error = heapString(err.Error())
+		return
+	}
+
 	// This code is all awful but at least it gets the job done right now.
 	var tx *template.Template
-	funcs := template.FuncMap{
-		"find": (typeFinder{root.Request}).Find,
-		"fexec": func(name, outfile string, data ...interface{}) error {
-			subroot := root
-			if len(data) == 1 {
-				d := data[0]
-				if _, ok := d.(FlatTypeRoot); !ok {
-					subroot.ExecParam = d
-				}
-			} else if len(data) > 1 {
-				subroot.ExecParam = data
+	inserts := newInsertions(policy)
+	types := newTypeFinder(root.Request)
+
+	execRoot := func(data []interface{}) FlatTypeRoot {
+		subroot := root
+		if len(data) == 1 {
+			d := data[0]
+			if _, ok := d.(FlatTypeRoot); !ok {
+				subroot.ExecParam = d
 			}
+		} else if len(data) > 1 {
+			subroot.ExecParam = data
+		}
+		return subroot
+	}
+
+	// fileExec returns a template function that executes a template and
+	// writes its output to a file using mode.
+	fileExec := func(fn string, mode writeMode) func(string, string, ...interface{}) (string, error) {
+		return func(name, outfile string, data ...interface{}) (string, error) {
+			subroot := execRoot(data)
 
 			var out io.Writer = ioutil.Discard
 			if len(name) > 0 {
-				b, ok := files[outfile]
-				if !ok {
-					b = &bytes.Buffer{}
-					files[outfile] = b
+				outfile, err := policy.check(outfile)
+				if err != nil {
+					return "", fmt.Errorf("%s %q: %v", fn, name, err)
 				}
+
+				f := files.open(outfile, root)
+				b, err := f.writer(outfile, mode)
+				if err != nil {
+					return "", fmt.Errorf("%s %q: %v", fn, name, err)
+				}
+				f.wrote(name)
 				out = b
 				subroot.HasData = b.Len() > 0
 			}
 
 			if name != "" {
-				return tx.ExecuteTemplate(out, name, subroot)
+				return "", tx.ExecuteTemplate(out, name, subroot)
 			} else {
-				return tx.Execute(out, subroot)
+				return "", tx.Execute(out, subroot)
 			}
-		},
+		}
+	}
+
+	// fileSection returns a template function that sets the header or footer
+	// of a file. The section is executed once when the file is finished,
+	// replacing any previously set header or footer.
+	fileSection := func(fn string, set func(*outputFile, *section)) func(string, string, ...interface{}) (string, error) {
+		return func(name, outfile string, data ...interface{}) (string, error) {
+			if tx.Lookup(name) == nil {
+				return "", fmt.Errorf("%s: template %q not defined", fn, name)
+			}
+			outfile, err := policy.check(outfile)
+			if err != nil {
+				return "", fmt.Errorf("%s %q: %v", fn, name, err)
+			}
+			set(files.open(outfile, root), &section{name, execRoot(data)})
+			return "", nil
+		}
+	}
+
+	funcs := template.FuncMap{
+		"find":       types.Find,
+		"resolve":    types.resolve,
+		"parent":     types.parent,
+		"file_of":    types.fileOf,
+		"full_name":  types.fullName,
+		"package_of": types.packageOf,
+		"fexec":      fileExec("fexec", writeAppend),
+		"fappend":    fileExec("fappend", writeAppend),
+		"fwrite":     fileExec("fwrite", writeExclusive),
+		"freplace":   fileExec("freplace", writeReplace),
+		"fheader":    fileSection("fheader", func(f *outputFile, s *section) { f.header = s }),
+		"ffooter":    fileSection("ffooter", func(f *outputFile, s *section) { f.footer = s }),
 
 		"exec": func(name string, dot ...interface{}) (string, error) {
 			var data interface{} = dot
@@ -451,9 +446,19 @@ func main() {
 		},
 	}
 
-	tx = template.New("").Delims(left, right).Funcs(mergeTypeChecks(copyDefaultTemplateFuncs(funcs)))
+	funcs = copyDefaultTemplateFuncs(funcs)
+	funcs = mergeTypeChecks(funcs, types)
+	funcs = mergeSourceInfo(funcs, types)
+	funcs = mergeOptions(funcs, types)
+	funcs = mergeWireFormat(funcs, types)
+	funcs = mergeRanges(funcs)
+	funcs = mergeOneofs(funcs, types)
+	funcs = mergeTypeMaps(funcs, types, typeMaps)
+	funcs = mergeDefaults(funcs, types, typeMaps)
+	funcs = mergeInsertions(funcs, inserts)
+	tx = template.New("").Delims(left, right).Funcs(funcs)
 
-	tx, err := tx.ParseFiles(params["template"]...)
+	tx, err = tx.ParseFiles(params["template"]...)
 	if err != nil {
 		resp.Error = heapString("error parsing template(s): " + err.Error())
 		return
@@ -464,20 +469,81 @@ func main() {
 		templates = tn
 	}
 
-	for _, name := range templates {
-		if err := tx.ExecuteTemplate(ioutil.Discard, name, root); err != nil {
+	if !params.Bool("per_file", false) {
+		for _, name := range templates {
+			if err := tx.ExecuteTemplate(ioutil.Discard, name, root); err != nil {
+				resp.Error = heapString(err.Error())
+				return
+			}
+		}
+	} else {
+		patterns, err := outputNames(params["out"], templates)
+		if err != nil {
 			resp.Error = heapString(err.Error())
 			return
 		}
+
+		protoFiles := make(map[string]*desc.FileDescriptorProto, len(req.GetProtoFile()))
+		for _, pkg := range req.GetProtoFile() {
+			protoFiles[pkg.GetName()] = pkg
+		}
+
+		base := root
+		for _, file := range req.GetFileToGenerate() {
+			pkg, ok := protoFiles[file]
+			if !ok {
+				resp.Error = heapString("file to generate not found: " + file)
+				return
+			}
+
+			root = base
+			root.File = pkg
+			root.Exported = flatTypesForFile(pkg, newFlatTypes(mapEntries))
+			for i, name := range templates {
+				outfile, err := outputName(patterns[i], pkg)
+				if err == nil {
+					outfile, err = policy.check(outfile)
+				}
+				if err != nil {
+					resp.Error = heapString(fmt.Sprintf("template %s: %v", name, err))
+					return
+				}
+
+				f := files.open(outfile, root)
+				f.wrote(name)
+				b := &f.body
+				root.HasData = b.Len() > 0
+				if err := tx.ExecuteTemplate(b, name, root); err != nil {
+					resp.Error = heapString(err.Error())
+					return
+				}
+			}
+		}
 	}
 
-	for name, buf := range files {
+	pp := newPostProcessor(params)
+	names := make([]string, 0, len(files.files))
+	for name := range files.files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		content, err := files.files[name].render(tx, name, pp)
+		if err != nil {
+			resp.Error = heapString(fmt.Sprintf("%s: %v", name, err))
+			return
+		}
+
 		f := &compiler.CodeGeneratorResponse_File{
 			Name:    heapString(name),
-			Content: heapString(buf.String()),
+			Content: heapString(content),
 		}
 
 		log.Printf("OUT=%q", name)
 		resp.File = append(resp.File, f)
 	}
+
+	// Insertions go last so that they may target files generated above.
+	resp.File = append(resp.File, inserts.files()...)
 }
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"text/template"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+)
+
+func mergeOneofs(funcs template.FuncMap, types typeFinder) template.FuncMap {
+	if funcs == nil {
+		funcs = make(template.FuncMap)
+	}
+
+	funcs["oneofs"] = oneofs
+	funcs["oneof_of"] = types.oneofOf
+	funcs["members"] = members
+	return funcs
+}
+
+// Oneof is a oneof declared in a message along with its fields, in
+// declaration order. A *Oneof may be passed to any function that accepts a
+// oneof descriptor.
+type Oneof struct {
+	*desc.OneofDescriptorProto
+	// Index is the oneof's index in its message's oneof declarations.
+	Index  int
+	Fields []*desc.FieldDescriptorProto
+}
+
+// Member is either a field or a oneof of a message. Exactly one of Field and
+// Oneof is set.
+type Member struct {
+	Field *desc.FieldDescriptorProto
+	Oneof *Oneof
+}
+
+// unwrapOneof returns the descriptor of d if d is a *Oneof, or d otherwise.
+// Functions taking any descriptor call it so that the oneofs returned by
+// oneofs and oneof_of may be passed to them.
+func unwrapOneof(d interface{}) interface{} {
+	if o, ok := d.(*Oneof); ok && o != nil {
+		return o.OneofDescriptorProto
+	}
+	return d
+}
+
+// IsOneof returns true if the member is a oneof.
+func (m Member) IsOneof() bool {
+	return m.Oneof != nil
+}
+
+// oneofs returns the oneofs of msg with their fields. Synthetic oneofs of
+// proto3 optional fields are omitted.
+func oneofs(msg *desc.DescriptorProto) []*Oneof {
+	all := make([]*Oneof, len(msg.GetOneofDecl()))
+	for i, o := range msg.GetOneofDecl() {
+		all[i] = &Oneof{OneofDescriptorProto: o, Index: i}
+	}
+
+	for _, f := range msg.GetField() {
+		if !isOneOf(f) {
+			continue
+		}
+		if i := int(f.GetOneofIndex()); i < len(all) {
+			all[i].Fields = append(all[i].Fields, f)
+		}
+	}
+
+	out := all[:0]
+	for _, o := range all {
+		if len(o.Fields) > 0 {
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+// oneofOf returns the oneof that field is a member of, or nil if field is
+// not in a oneof or is a proto3 optional field.
+func (t typeFinder) oneofOf(field *desc.FieldDescriptorProto) *Oneof {
+	if !isOneOf(field) {
+		return nil
+	}
+
+	msg, ok := t.parent(field).(*desc.DescriptorProto)
+	if !ok {
+		return nil
+	}
+	for _, o := range oneofs(msg) {
+		if o.Index == int(field.GetOneofIndex()) {
+			return o
+		}
+	}
+	return nil
+}
+
+// members returns the fields of msg with the fields of each oneof grouped
+// into a single member. Members are in declaration order, with each oneof
+// placed where its first field is declared.
+func members(msg *desc.DescriptorProto) []Member {
+	groups := oneofs(msg)
+	byIndex := make(map[int]*Oneof, len(groups))
+	for _, o := range groups {
+		byIndex[o.Index] = o
+	}
+
+	out := make([]Member, 0, len(msg.GetField()))
+	for _, f := range msg.GetField() {
+		if !isOneOf(f) {
+			out = append(out, Member{Field: f})
+			continue
+		}
+
+		i := int(f.GetOneofIndex())
+		if o, ok := byIndex[i]; ok {
+			out = append(out, Member{Oneof: o})
+			delete(byIndex, i)
+		}
+	}
+	return out
+}
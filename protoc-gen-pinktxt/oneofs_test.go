@@ -0,0 +1,184 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+	compiler "github.com/nilium/pinktxt/internal/plugin/google/protobuf/compiler"
+)
+
+func testOneofField(name string, oneof int32) *desc.FieldDescriptorProto {
+	f := &desc.FieldDescriptorProto{
+		Name:   proto.String(name),
+		Number: proto.Int32(1),
+		Label:  desc.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:   desc.FieldDescriptorProto_TYPE_INT32.Enum(),
+	}
+	if oneof >= 0 {
+		f.OneofIndex = proto.Int32(oneof)
+	}
+	return f
+}
+
+// testOneofRequest returns a request with a message whose fields are, in
+// order: a, b (oneof first), maybe (proto3 optional), c (oneof second), d
+// (oneof first), e, f (oneof second). The oneof empty has no fields.
+func testOneofRequest() *compiler.CodeGeneratorRequest {
+	maybe := testOneofField("maybe", 1)
+	maybe.Proto3Optional = proto.Bool(true)
+
+	return &compiler.CodeGeneratorRequest{
+		ProtoFile: []*desc.FileDescriptorProto{
+			{
+				Name:    proto.String("oneof.proto"),
+				Package: proto.String("oo"),
+				Syntax:  proto.String("proto3"),
+				MessageType: []*desc.DescriptorProto{
+					{
+						Name: proto.String("M"),
+						Field: []*desc.FieldDescriptorProto{
+							testOneofField("a", -1),
+							testOneofField("b", 0),
+							maybe,
+							testOneofField("c", 2),
+							testOneofField("d", 0),
+							testOneofField("e", -1),
+							testOneofField("f", 2),
+						},
+						OneofDecl: []*desc.OneofDescriptorProto{
+							{Name: proto.String("first")},
+							{Name: proto.String("_maybe")},
+							{
+								Name:    proto.String("second"),
+								Options: &desc.OneofOptions{UninterpretedOption: []*desc.UninterpretedOption{{IdentifierValue: proto.String("x")}}},
+							},
+							{Name: proto.String("empty")},
+						},
+					},
+				},
+				SourceCodeInfo: &desc.SourceCodeInfo{
+					Location: []*desc.SourceCodeInfo_Location{
+						testLocation("second", []int32{4, 2, 12}, 4, 0, 8, 2),
+					},
+				},
+			},
+		},
+	}
+}
+
+func oneofNames(os []*Oneof) []string {
+	names := make([]string, len(os))
+	for i, o := range os {
+		names[i] = o.GetName()
+		for _, f := range o.Fields {
+			names[i] += " " + f.GetName()
+		}
+	}
+	return names
+}
+
+func TestOneofs(t *testing.T) {
+	msg := testOneofRequest().ProtoFile[0].MessageType[0]
+
+	got := oneofNames(oneofs(msg))
+	want := []string{"first b d", "second c f"}
+	if len(got) != len(want) {
+		t.Fatalf("oneofs(M) = %q; want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("oneofs(M)[%d] = %q; want %q", i, got[i], want[i])
+		}
+	}
+
+	if os := oneofs(msg); os[0].Index != 0 || os[1].Index != 2 {
+		t.Errorf("oneofs(M) indices = %d, %d; want 0, 2", os[0].Index, os[1].Index)
+	}
+}
+
+func TestMembers(t *testing.T) {
+	msg := testOneofRequest().ProtoFile[0].MessageType[0]
+
+	var got []string
+	for _, m := range members(msg) {
+		if m.IsOneof() {
+			got = append(got, "("+m.Oneof.GetName()+")")
+		} else {
+			got = append(got, m.Field.GetName())
+		}
+	}
+
+	want := []string{"a", "(first)", "maybe", "(second)", "e"}
+	if len(got) != len(want) {
+		t.Fatalf("members(M) = %q; want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("members(M)[%d] = %q; want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestOneofOf(t *testing.T) {
+	req := testOneofRequest()
+	msg := req.ProtoFile[0].MessageType[0]
+	finder := newTypeFinder(req)
+
+	cases := map[string]string{
+		"a": "", "b": "first", "maybe": "", "c": "second", "d": "first", "e": "", "f": "second",
+	}
+	for _, f := range msg.Field {
+		got := ""
+		if o := finder.oneofOf(f); o != nil {
+			got = o.GetName()
+		}
+		if want := cases[f.GetName()]; got != want {
+			t.Errorf("oneofOf(%s) = %q; want %q", f.GetName(), got, want)
+		}
+	}
+}
+
+func TestOneofDescriptorFuncs(t *testing.T) {
+	req := testOneofRequest()
+	msg := req.ProtoFile[0].MessageType[0]
+	finder := newTypeFinder(req)
+	second := finder.oneofOf(msg.Field[3])
+
+	if got := finder.fullName(second); got != ".oo.M.second" {
+		t.Errorf("fullName(second) = %q; want %q", got, ".oo.M.second")
+	}
+	if got := finder.parent(second); got != msg {
+		t.Errorf("parent(second) = %v; want %v", got, msg)
+	}
+	if got := finder.packageOf(second); got != "oo" {
+		t.Errorf("packageOf(second) = %q; want %q", got, "oo")
+	}
+	if got := finder.comments(second).Leading; got != "second" {
+		t.Errorf("comments(second).Leading = %q; want %q", got, "second")
+	}
+	if got := finder.location(second).String(); got != "oneof.proto:5:3" {
+		t.Errorf("location(second) = %q; want %q", got, "oneof.proto:5:3")
+	}
+	if got, err := finder.resolve("first", second); err != nil || got != msg.OneofDecl[0] {
+		t.Errorf("resolve(first, second) = %v, %v; want %v", got, err, msg.OneofDecl[0])
+	}
+
+	if isSyntheticOneof(msg, second) {
+		t.Errorf("is_synthetic_oneof(second) = true; want false")
+	}
+	if maybe := (&Oneof{OneofDescriptorProto: msg.OneofDecl[1], Index: 1}); !isSyntheticOneof(msg, maybe) {
+		t.Errorf("is_synthetic_oneof(_maybe) = false; want true")
+	}
+
+	oneofOption := standardOptionOf("oneof_option", reflect.TypeOf(msg.OneofDecl[0]))
+	opt, err := oneofOption("uninterpreted_option", second)
+	if err != nil {
+		t.Fatalf("oneof_option(uninterpreted_option, second) error = %v", err)
+	}
+	if opts, ok := opt.([]*desc.UninterpretedOption); !ok || len(opts) != 1 {
+		t.Errorf("oneof_option(uninterpreted_option, second) = %#v; want 1 option", opt)
+	}
+}
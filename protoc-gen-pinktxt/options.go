@@ -0,0 +1,418 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"github.com/gogo/protobuf/proto"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+)
+
+func mergeOptions(funcs template.FuncMap, types typeFinder) template.FuncMap {
+	if funcs == nil {
+		funcs = make(template.FuncMap)
+	}
+
+	funcs["option"] = types.option
+	funcs["is_deprecated"] = isDeprecated
+	funcs["is_packed"] = types.isPacked
+	funcs["is_lazy"] = isLazy
+	funcs["json_name"] = jsonName
+	funcs["file_option"] = standardOptionOf("file_option", reflect.TypeOf((*desc.FileDescriptorProto)(nil)))
+	funcs["message_option"] = standardOptionOf("message_option", reflect.TypeOf((*desc.DescriptorProto)(nil)))
+	funcs["field_option"] = standardOptionOf("field_option", reflect.TypeOf((*desc.FieldDescriptorProto)(nil)))
+	funcs["oneof_option"] = standardOptionOf("oneof_option", reflect.TypeOf((*desc.OneofDescriptorProto)(nil)))
+	funcs["enum_option"] = standardOptionOf("enum_option", reflect.TypeOf((*desc.EnumDescriptorProto)(nil)))
+	funcs["enum_value_option"] = standardOptionOf("enum_value_option", reflect.TypeOf((*desc.EnumValueDescriptorProto)(nil)))
+	funcs["service_option"] = standardOptionOf("service_option", reflect.TypeOf((*desc.ServiceDescriptorProto)(nil)))
+	funcs["method_option"] = standardOptionOf("method_option", reflect.TypeOf((*desc.MethodDescriptorProto)(nil)))
+	return funcs
+}
+
+// Wire types, as used in field tags.
+const (
+	wireVarint     = 0
+	wireFixed64    = 1
+	wireBytes      = 2
+	wireStartGroup = 3
+	wireEndGroup   = 4
+	wireFixed32    = 5
+)
+
+var errTruncated = errors.New("truncated protobuf data")
+
+// optionsOf returns the options message of d and the fully-qualified name of
+// its type, which is the extendee of custom options for d.
+func optionsOf(d interface{}) (proto.Message, string) {
+	switch d := unwrapOneof(d).(type) {
+	case *desc.FileDescriptorProto:
+		return d.GetOptions(), ".google.protobuf.FileOptions"
+	case *desc.DescriptorProto:
+		return d.GetOptions(), ".google.protobuf.MessageOptions"
+	case *desc.FieldDescriptorProto:
+		return d.GetOptions(), ".google.protobuf.FieldOptions"
+	case *desc.OneofDescriptorProto:
+		return d.GetOptions(), ".google.protobuf.OneofOptions"
+	case *desc.EnumDescriptorProto:
+		return d.GetOptions(), ".google.protobuf.EnumOptions"
+	case *desc.EnumValueDescriptorProto:
+		return d.GetOptions(), ".google.protobuf.EnumValueOptions"
+	case *desc.ServiceDescriptorProto:
+		return d.GetOptions(), ".google.protobuf.ServiceOptions"
+	case *desc.MethodDescriptorProto:
+		return d.GetOptions(), ".google.protobuf.MethodOptions"
+	}
+	return nil, ""
+}
+
+// option returns the value of the custom option (extension) name set on d.
+// The name may be fully-qualified or relative to the scope of d, as in
+// .proto files.
+//
+// Scalars are returned as their Go equivalents, enums as the name of their
+// value, and messages as a map of field names to values. Repeated options
+// are returned as a slice of values. If the option is not set, option
+// returns nil.
+func (t typeFinder) option(name string, d interface{}) (interface{}, error) {
+	opts, extendee := optionsOf(d)
+	if extendee == "" {
+		return nil, fmt.Errorf("option %s: %T has no options", name, d)
+	}
+
+	// Allow names to be written as they are in .proto files, e.g. (foo.bar).
+	found, err := t.resolve(strings.TrimSuffix(strings.TrimPrefix(name, "("), ")"), d)
+	if err != nil {
+		return nil, fmt.Errorf("option %s: %v", name, err)
+	}
+
+	ext, _ := found.(*desc.FieldDescriptorProto)
+	if ext == nil || ext.Extendee == nil {
+		return nil, fmt.Errorf("option %s: not an extension", name)
+	} else if ext.GetExtendee() != extendee {
+		return nil, fmt.Errorf("option %s: extends %s, not %s", name, ext.GetExtendee()[1:], extendee[1:])
+	}
+
+	if reflect.ValueOf(opts).IsNil() {
+		return nil, nil
+	}
+
+	// Custom options are kept as encoded extensions, so re-encode the
+	// options and decode the fields belonging to the extension.
+	b, err := proto.Marshal(opts)
+	if err != nil {
+		return nil, fmt.Errorf("option %s: %v", name, err)
+	}
+
+	fields := map[int32]*desc.FieldDescriptorProto{ext.GetNumber(): ext}
+	values, err := t.decodeFields(&wireReader{buf: b}, fields, -1)
+	if err != nil {
+		return nil, fmt.Errorf("option %s: %v", name, err)
+	}
+	return values[ext.GetName()], nil
+}
+
+// decodeMessage decodes a message of the given type into a map of field
+// names to values.
+func (t typeFinder) decodeMessage(r *wireReader, typeName string, group int32) (map[string]interface{}, error) {
+	msg, ok := t.Find(typeName).(*desc.DescriptorProto)
+	if !ok {
+		return nil, fmt.Errorf("message type %s not found", typeName)
+	}
+
+	fields := make(map[int32]*desc.FieldDescriptorProto, len(msg.GetField()))
+	for _, f := range msg.GetField() {
+		fields[f.GetNumber()] = f
+	}
+	return t.decodeFields(r, fields, group)
+}
+
+// decodeFields decodes all known fields from r, skipping any others. If
+// group is not negative, decoding stops at the end-group tag for the group's
+// field number.
+func (t typeFinder) decodeFields(r *wireReader, fields map[int32]*desc.FieldDescriptorProto, group int32) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+	for !r.done() {
+		tag, err := r.varint()
+		if err != nil {
+			return nil, err
+		}
+		num, wt := int32(tag>>3), int(tag&7)
+
+		if wt == wireEndGroup {
+			if num != group {
+				return nil, fmt.Errorf("unexpected end of group %d", num)
+			}
+			return values, nil
+		}
+
+		f := fields[num]
+		if f == nil {
+			if err := r.skip(num, wt); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		var vals []interface{}
+		if wt == wireBytes && isPackable(f.GetType()) {
+			b, err := r.bytes()
+			if err != nil {
+				return nil, err
+			}
+			packed := &wireReader{buf: b}
+			for !packed.done() {
+				v, err := t.decodeValue(packed, f, wireTypeOf(f.GetType()))
+				if err != nil {
+					return nil, err
+				}
+				vals = append(vals, v)
+			}
+		} else {
+			v, err := t.decodeValue(r, f, wt)
+			if err != nil {
+				return nil, err
+			}
+			vals = append(vals, v)
+		}
+
+		name := f.GetName()
+		switch {
+		case f.GetLabel() == desc.FieldDescriptorProto_LABEL_REPEATED:
+			prev, _ := values[name].([]interface{})
+			values[name] = append(prev, vals...)
+		case len(vals) == 0:
+		default:
+			v := vals[len(vals)-1]
+			// Non-repeated messages are merged when they occur more than
+			// once.
+			if m, ok := v.(map[string]interface{}); ok {
+				if prev, ok := values[name].(map[string]interface{}); ok {
+					v = mergeMessage(prev, m)
+				}
+			}
+			values[name] = v
+		}
+	}
+
+	if group >= 0 {
+		return nil, errTruncated
+	}
+	return values, nil
+}
+
+// mergeMessage merges the decoded message src into dst the way protobuf
+// merges a message that occurs more than once: repeated fields are
+// concatenated, messages are merged recursively, and other fields in src
+// replace those in dst.
+func mergeMessage(dst, src map[string]interface{}) map[string]interface{} {
+	for k, v := range src {
+		switch v := v.(type) {
+		case []interface{}:
+			prev, _ := dst[k].([]interface{})
+			dst[k] = append(prev, v...)
+		case map[string]interface{}:
+			if prev, ok := dst[k].(map[string]interface{}); ok {
+				dst[k] = mergeMessage(prev, v)
+			} else {
+				dst[k] = v
+			}
+		default:
+			dst[k] = v
+		}
+	}
+	return dst
+}
+
+func (t typeFinder) decodeValue(r *wireReader, f *desc.FieldDescriptorProto, wt int) (interface{}, error) {
+	typ := f.GetType()
+	if want := wireTypeOf(typ); wt != want {
+		return nil, fmt.Errorf("field %s: wire type %d does not match %s", f.GetName(), wt, typ)
+	}
+
+	switch typ {
+	case desc.FieldDescriptorProto_TYPE_DOUBLE:
+		v, err := r.fixed64()
+		return math.Float64frombits(v), err
+	case desc.FieldDescriptorProto_TYPE_FLOAT:
+		v, err := r.fixed32()
+		return math.Float32frombits(v), err
+	case desc.FieldDescriptorProto_TYPE_FIXED64:
+		return r.fixed64()
+	case desc.FieldDescriptorProto_TYPE_SFIXED64:
+		v, err := r.fixed64()
+		return int64(v), err
+	case desc.FieldDescriptorProto_TYPE_FIXED32:
+		return r.fixed32()
+	case desc.FieldDescriptorProto_TYPE_SFIXED32:
+		v, err := r.fixed32()
+		return int32(v), err
+	case desc.FieldDescriptorProto_TYPE_STRING:
+		v, err := r.bytes()
+		return string(v), err
+	case desc.FieldDescriptorProto_TYPE_BYTES:
+		v, err := r.bytes()
+		return append([]byte(nil), v...), err
+	case desc.FieldDescriptorProto_TYPE_MESSAGE:
+		b, err := r.bytes()
+		if err != nil {
+			return nil, err
+		}
+		return t.decodeMessage(&wireReader{buf: b}, f.GetTypeName(), -1)
+	case desc.FieldDescriptorProto_TYPE_GROUP:
+		return t.decodeMessage(r, f.GetTypeName(), f.GetNumber())
+	}
+
+	v, err := r.varint()
+	if err != nil {
+		return nil, err
+	}
+
+	switch typ {
+	case desc.FieldDescriptorProto_TYPE_INT64:
+		return int64(v), nil
+	case desc.FieldDescriptorProto_TYPE_UINT64:
+		return v, nil
+	case desc.FieldDescriptorProto_TYPE_INT32:
+		return int32(v), nil
+	case desc.FieldDescriptorProto_TYPE_UINT32:
+		return uint32(v), nil
+	case desc.FieldDescriptorProto_TYPE_BOOL:
+		return v != 0, nil
+	case desc.FieldDescriptorProto_TYPE_SINT32:
+		return int32(uint32(v)>>1) ^ -int32(v&1), nil
+	case desc.FieldDescriptorProto_TYPE_SINT64:
+		return int64(v>>1) ^ -int64(v&1), nil
+	case desc.FieldDescriptorProto_TYPE_ENUM:
+		if e, ok := t.Find(f.GetTypeName()).(*desc.EnumDescriptorProto); ok {
+			for _, ev := range e.GetValue() {
+				if ev.GetNumber() == int32(v) {
+					return ev.GetName(), nil
+				}
+			}
+		}
+		// Unknown enum values are returned as numbers.
+		return int32(v), nil
+	}
+
+	return nil, fmt.Errorf("field %s: unsupported type %s", f.GetName(), typ)
+}
+
+func wireTypeOf(typ desc.FieldDescriptorProto_Type) int {
+	switch typ {
+	case desc.FieldDescriptorProto_TYPE_DOUBLE,
+		desc.FieldDescriptorProto_TYPE_FIXED64,
+		desc.FieldDescriptorProto_TYPE_SFIXED64:
+		return wireFixed64
+	case desc.FieldDescriptorProto_TYPE_FLOAT,
+		desc.FieldDescriptorProto_TYPE_FIXED32,
+		desc.FieldDescriptorProto_TYPE_SFIXED32:
+		return wireFixed32
+	case desc.FieldDescriptorProto_TYPE_STRING,
+		desc.FieldDescriptorProto_TYPE_BYTES,
+		desc.FieldDescriptorProto_TYPE_MESSAGE:
+		return wireBytes
+	case desc.FieldDescriptorProto_TYPE_GROUP:
+		return wireStartGroup
+	}
+	return wireVarint
+}
+
+// isPackable returns true if repeated fields of typ may use packed encoding.
+func isPackable(typ desc.FieldDescriptorProto_Type) bool {
+	return wireTypeOf(typ) != wireBytes && typ != desc.FieldDescriptorProto_TYPE_GROUP
+}
+
+// wireReader reads protobuf wire format values from a buffer.
+type wireReader struct {
+	buf []byte
+}
+
+func (r *wireReader) done() bool {
+	return len(r.buf) == 0
+}
+
+func (r *wireReader) varint() (uint64, error) {
+	var x uint64
+	for i := 0; i < 10 && i < len(r.buf); i++ {
+		b := r.buf[i]
+		x |= uint64(b&0x7f) << (7 * uint(i))
+		if b < 0x80 {
+			r.buf = r.buf[i+1:]
+			return x, nil
+		}
+	}
+	return 0, errTruncated
+}
+
+func (r *wireReader) fixed64() (uint64, error) {
+	if len(r.buf) < 8 {
+		return 0, errTruncated
+	}
+	var x uint64
+	for i := 7; i >= 0; i-- {
+		x = x<<8 | uint64(r.buf[i])
+	}
+	r.buf = r.buf[8:]
+	return x, nil
+}
+
+func (r *wireReader) fixed32() (uint32, error) {
+	if len(r.buf) < 4 {
+		return 0, errTruncated
+	}
+	var x uint32
+	for i := 3; i >= 0; i-- {
+		x = x<<8 | uint32(r.buf[i])
+	}
+	r.buf = r.buf[4:]
+	return x, nil
+}
+
+func (r *wireReader) bytes() ([]byte, error) {
+	n, err := r.varint()
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(r.buf)) < n {
+		return nil, errTruncated
+	}
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+	return b, nil
+}
+
+// skip discards the value of field num with wire type wt.
+func (r *wireReader) skip(num int32, wt int) (err error) {
+	switch wt {
+	case wireVarint:
+		_, err = r.varint()
+	case wireFixed64:
+		_, err = r.fixed64()
+	case wireFixed32:
+		_, err = r.fixed32()
+	case wireBytes:
+		_, err = r.bytes()
+	case wireStartGroup:
+		for err == nil {
+			var tag uint64
+			if tag, err = r.varint(); err != nil {
+				break
+			}
+			if int(tag&7) == wireEndGroup {
+				if int32(tag>>3) != num {
+					return fmt.Errorf("unexpected end of group %d", tag>>3)
+				}
+				return nil
+			}
+			err = r.skip(int32(tag>>3), int(tag&7))
+		}
+	default:
+		err = fmt.Errorf("invalid wire type %d", wt)
+	}
+	return err
+}
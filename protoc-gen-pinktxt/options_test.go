@@ -0,0 +1,311 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+	compiler "github.com/nilium/pinktxt/internal/plugin/google/protobuf/compiler"
+)
+
+// Field numbers of the test extensions of MessageOptions.
+const (
+	testOptPacked = 50001
+	testOptGroup  = 50002
+	testOptMsg    = 50003
+	testOptKind   = 50004
+	testOptPlain  = 50005
+	testOptInner  = 50006
+)
+
+func testOptionExt(name string, num int32, label desc.FieldDescriptorProto_Label, typ desc.FieldDescriptorProto_Type, typeName string) *desc.FieldDescriptorProto {
+	f := &desc.FieldDescriptorProto{
+		Name:     proto.String(name),
+		Number:   proto.Int32(num),
+		Label:    label.Enum(),
+		Type:     typ.Enum(),
+		Extendee: proto.String(".google.protobuf.MessageOptions"),
+	}
+	if typeName != "" {
+		f.TypeName = proto.String(typeName)
+	}
+	return f
+}
+
+func testOptionRequest() *compiler.CodeGeneratorRequest {
+	const (
+		optional = desc.FieldDescriptorProto_LABEL_OPTIONAL
+		repeated = desc.FieldDescriptorProto_LABEL_REPEATED
+	)
+
+	return &compiler.CodeGeneratorRequest{
+		ProtoFile: []*desc.FileDescriptorProto{
+			{
+				Name:    proto.String("opt/o.proto"),
+				Package: proto.String("opt"),
+				MessageType: []*desc.DescriptorProto{
+					{Name: proto.String("Target")},
+					{
+						Name: proto.String("Opt"),
+						Field: []*desc.FieldDescriptorProto{
+							{Name: proto.String("a"), Number: proto.Int32(1), Label: optional.Enum(), Type: desc.FieldDescriptorProto_TYPE_STRING.Enum()},
+							{Name: proto.String("b"), Number: proto.Int32(2), Label: repeated.Enum(), Type: desc.FieldDescriptorProto_TYPE_INT32.Enum()},
+							{Name: proto.String("sub"), Number: proto.Int32(3), Label: optional.Enum(), Type: desc.FieldDescriptorProto_TYPE_MESSAGE.Enum(), TypeName: proto.String(".opt.Opt")},
+							{Name: proto.String("k"), Number: proto.Int32(4), Label: optional.Enum(), Type: desc.FieldDescriptorProto_TYPE_ENUM.Enum(), TypeName: proto.String(".opt.Kind")},
+						},
+					},
+					{
+						Name: proto.String("G"),
+						Field: []*desc.FieldDescriptorProto{
+							{Name: proto.String("gx"), Number: proto.Int32(1), Label: optional.Enum(), Type: desc.FieldDescriptorProto_TYPE_INT32.Enum()},
+						},
+					},
+					{
+						Name: proto.String("Scope"),
+						Extension: []*desc.FieldDescriptorProto{
+							testOptionExt("inner_ext", testOptInner, optional, desc.FieldDescriptorProto_TYPE_STRING, ""),
+						},
+					},
+				},
+				EnumType: []*desc.EnumDescriptorProto{
+					{
+						Name: proto.String("Kind"),
+						Value: []*desc.EnumValueDescriptorProto{
+							{Name: proto.String("KIND_A"), Number: proto.Int32(0)},
+							{Name: proto.String("KIND_B"), Number: proto.Int32(1)},
+						},
+					},
+				},
+				Extension: []*desc.FieldDescriptorProto{
+					testOptionExt("packed", testOptPacked, repeated, desc.FieldDescriptorProto_TYPE_SINT32, ""),
+					testOptionExt("grp", testOptGroup, optional, desc.FieldDescriptorProto_TYPE_GROUP, ".opt.G"),
+					testOptionExt("msg", testOptMsg, optional, desc.FieldDescriptorProto_TYPE_MESSAGE, ".opt.Opt"),
+					testOptionExt("kind", testOptKind, optional, desc.FieldDescriptorProto_TYPE_ENUM, ".opt.Kind"),
+					testOptionExt("plain", testOptPlain, optional, desc.FieldDescriptorProto_TYPE_STRING, ""),
+				},
+			},
+		},
+	}
+}
+
+// testWire concatenates encoded protobuf values. Arguments are tags (as
+// testTag), varints (uint64), strings (length-delimited), or raw bytes.
+func testWire(vals ...interface{}) []byte {
+	var b []byte
+	for _, v := range vals {
+		switch v := v.(type) {
+		case testTag:
+			b = appendVarint(b, uint64(v.num)<<3|uint64(v.wt))
+		case uint64:
+			b = appendVarint(b, v)
+		case string:
+			b = appendVarint(b, uint64(len(v)))
+			b = append(b, v...)
+		case []byte:
+			b = append(b, v...)
+		}
+	}
+	return b
+}
+
+type testTag struct {
+	num int32
+	wt  int
+}
+
+// testDelimited returns b prefixed with its length.
+func testDelimited(b []byte) []byte {
+	return append(appendVarint(nil, uint64(len(b))), b...)
+}
+
+func TestOptionDecode(t *testing.T) {
+	opt := testWire(
+		testTag{1, wireBytes}, "x",
+		testTag{2, wireVarint}, uint64(1),
+		testTag{3, wireBytes}, testDelimited(testWire(testTag{1, wireBytes}, "inner", testTag{2, wireVarint}, uint64(10))),
+	)
+	optAgain := testWire(
+		testTag{2, wireVarint}, uint64(2),
+		testTag{3, wireBytes}, testDelimited(testWire(testTag{2, wireVarint}, uint64(20))),
+		testTag{4, wireVarint}, uint64(1),
+	)
+
+	cases := []struct {
+		name string
+		opts []byte
+		want interface{}
+	}{
+		{
+			// Packed and unpacked values of a repeated option are combined.
+			name: "packed",
+			opts: testWire(
+				testTag{testOptPacked, wireBytes}, testDelimited(testWire(uint64(2), uint64(3), uint64(600))),
+				testTag{testOptPacked, wireVarint}, uint64(1),
+			),
+			want: []interface{}{int32(1), int32(-2), int32(300), int32(-1)},
+		},
+		{
+			// Unknown fields inside the group are skipped.
+			name: "grp",
+			opts: testWire(
+				testTag{testOptGroup, wireStartGroup},
+				testTag{1, wireVarint}, uint64(7),
+				testTag{99, wireFixed32}, []byte{1, 2, 3, 4},
+				testTag{98, wireStartGroup}, testTag{1, wireVarint}, uint64(1), testTag{98, wireEndGroup},
+				testTag{testOptGroup, wireEndGroup},
+			),
+			want: map[string]interface{}{"gx": int32(7)},
+		},
+		{
+			// A non-repeated message that occurs twice is merged.
+			name: "msg",
+			opts: testWire(
+				testTag{testOptMsg, wireBytes}, testDelimited(opt),
+				testTag{testOptMsg, wireBytes}, testDelimited(optAgain),
+			),
+			want: map[string]interface{}{
+				"a": "x",
+				"b": []interface{}{int32(1), int32(2)},
+				"sub": map[string]interface{}{
+					"a": "inner",
+					"b": []interface{}{int32(10), int32(20)},
+				},
+				"k": "KIND_B",
+			},
+		},
+		{
+			name: "kind",
+			opts: testWire(testTag{testOptKind, wireVarint}, uint64(1)),
+			want: "KIND_B",
+		},
+		{
+			// Unknown enum numbers are returned as numbers.
+			name: "kind",
+			opts: testWire(testTag{testOptKind, wireVarint}, uint64(7)),
+			want: int32(7),
+		},
+		{
+			// The last value of a non-repeated scalar wins.
+			name: "plain",
+			opts: testWire(testTag{testOptPlain, wireBytes}, "a", testTag{testOptPlain, wireBytes}, "b"),
+			want: "b",
+		},
+		{
+			name: "plain",
+			opts: testWire(testTag{testOptKind, wireVarint}, uint64(1)),
+			want: nil,
+		},
+	}
+
+	req := testOptionRequest()
+	target := req.ProtoFile[0].MessageType[0]
+	finder := newTypeFinder(req)
+	for _, c := range cases {
+		target.Options = &desc.MessageOptions{}
+		if err := proto.Unmarshal(c.opts, target.Options); err != nil {
+			t.Fatalf("%s: unmarshal options: %v", c.name, err)
+		}
+
+		got, err := finder.option(c.name, target)
+		if err != nil {
+			t.Errorf("option(%q) error = %v", c.name, err)
+		} else if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("option(%q) = %#v; want %#v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestOptionNames(t *testing.T) {
+	req := testOptionRequest()
+	file := req.ProtoFile[0]
+	target, scope := file.MessageType[0], file.MessageType[3]
+
+	opts := testWire(
+		testTag{testOptPlain, wireBytes}, "plain",
+		testTag{testOptInner, wireBytes}, "inner",
+	)
+	for _, m := range []*desc.DescriptorProto{target, scope} {
+		m.Options = &desc.MessageOptions{}
+		if err := proto.Unmarshal(opts, m.Options); err != nil {
+			t.Fatalf("unmarshal options: %v", err)
+		}
+	}
+
+	cases := []struct {
+		name string
+		d    interface{}
+		want string
+	}{
+		{"plain", target, "plain"},
+		{"(plain)", target, "plain"},
+		{"opt.plain", target, "plain"},
+		{"(opt.plain)", target, "plain"},
+		{"(.opt.plain)", target, "plain"},
+		{"(inner_ext)", scope, "inner"},
+		{"(Scope.inner_ext)", target, "inner"},
+		{"(opt.Scope.inner_ext)", target, "inner"},
+	}
+
+	finder := newTypeFinder(req)
+	for _, c := range cases {
+		got, err := finder.option(c.name, c.d)
+		if err != nil {
+			t.Errorf("option(%q) error = %v", c.name, err)
+		} else if got != c.want {
+			t.Errorf("option(%q) = %#v; want %q", c.name, got, c.want)
+		}
+	}
+
+	for _, name := range []string{
+		"inner_ext",    // not in scope of Target
+		"(opt.Target)", // not an extension
+		"(opt.missing)",
+	} {
+		if got, err := finder.option(name, target); err == nil {
+			t.Errorf("option(%q) = %#v; want error", name, got)
+		}
+	}
+	if got, err := finder.option("plain", file.EnumType[0]); err == nil {
+		t.Errorf("option(plain) on enum = %#v; want error", got)
+	}
+}
+
+func TestOptionDecodeErrors(t *testing.T) {
+	req := testOptionRequest()
+	fields := make(map[int32]*desc.FieldDescriptorProto)
+	for _, f := range req.ProtoFile[0].Extension {
+		fields[f.GetNumber()] = f
+	}
+
+	cases := []struct {
+		name string
+		buf  []byte
+	}{
+		{"truncated tag", []byte{0x80}},
+		{"truncated varint", testWire(testTag{testOptKind, wireVarint}, []byte{0xff})},
+		{"truncated length", testWire(testTag{testOptPlain, wireBytes})},
+		{"truncated bytes", testWire(testTag{testOptPlain, wireBytes}, uint64(5), []byte("abc"))},
+		{"truncated packed", testWire(testTag{testOptPacked, wireBytes}, testDelimited([]byte{0x80}))},
+		{"truncated message", testWire(testTag{testOptMsg, wireBytes}, testDelimited(testWire(testTag{1, wireBytes}, uint64(3))))},
+		{"unterminated group", testWire(testTag{testOptGroup, wireStartGroup}, testTag{1, wireVarint}, uint64(1))},
+		{"mismatched group", testWire(testTag{testOptGroup, wireStartGroup}, testTag{1, wireEndGroup})},
+		{"stray end group", testWire(testTag{testOptGroup, wireEndGroup})},
+		{"wrong wire type", testWire(testTag{testOptPlain, wireVarint}, uint64(1))},
+		{"skip truncated varint", testWire(testTag{7, wireVarint}, []byte{0x80})},
+		{"skip truncated fixed64", testWire(testTag{7, wireFixed64}, []byte{1, 2, 3})},
+		{"skip truncated fixed32", testWire(testTag{7, wireFixed32}, []byte{1, 2, 3})},
+		{"skip truncated bytes", testWire(testTag{7, wireBytes}, uint64(2), []byte{1})},
+		{"skip unterminated group", testWire(testTag{7, wireStartGroup}, testTag{1, wireVarint}, uint64(1))},
+		{"skip mismatched group", testWire(testTag{7, wireStartGroup}, testTag{8, wireEndGroup})},
+		{"skip invalid wire type", testWire(testTag{7, 6})},
+	}
+
+	finder := newTypeFinder(req)
+	for _, c := range cases {
+		if got, err := finder.decodeFields(&wireReader{buf: c.buf}, fields, -1); err == nil {
+			t.Errorf("%s: decodeFields(% x) = %v; want error", c.name, c.buf, got)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// writeMode controls how template output is written to an output file that
+// already has content.
+type writeMode int
+
+const (
+	// writeAppend appends to the file's content (fappend, fexec).
+	writeAppend writeMode = iota
+	// writeExclusive fails if the file already has content (fwrite).
+	writeExclusive
+	// writeReplace discards the file's content before writing (freplace).
+	writeReplace
+)
+
+// section is a template executed with data when an output file is finished.
+type section struct {
+	name string
+	data interface{}
+}
+
+// outputFile is a generated file. Its header and footer, if set, are
+// executed once each around the body when the file is finished.
+type outputFile struct {
+	body           bytes.Buffer
+	header, footer *section
+	// templates is the names of the templates that wrote to the body, in
+	// the order they first did so.
+	templates []string
+}
+
+// outputFiles holds generated files by name.
+type outputFiles struct {
+	files map[string]*outputFile
+	// header and footer are the default header and footer templates for new
+	// files (the header and footer parameters).
+	header, footer string
+}
+
+func newOutputFiles(header, footer string) *outputFiles {
+	return &outputFiles{
+		files:  make(map[string]*outputFile),
+		header: header,
+		footer: footer,
+	}
+}
+
+// open returns the output file with the given name, creating it if needed.
+// New files take the default header and footer, executed with data.
+func (o *outputFiles) open(name string, data interface{}) *outputFile {
+	f, ok := o.files[name]
+	if ok {
+		return f
+	}
+
+	f = &outputFile{}
+	if o.header != "" {
+		f.header = &section{o.header, data}
+	}
+	if o.footer != "" {
+		f.footer = &section{o.footer, data}
+	}
+	o.files[name] = f
+	return f
+}
+
+// wrote records that the template tpl wrote to f.
+func (f *outputFile) wrote(tpl string) {
+	for _, t := range f.templates {
+		if t == tpl {
+			return
+		}
+	}
+	f.templates = append(f.templates, tpl)
+}
+
+// writer returns the writer for the body of f according to mode.
+func (f *outputFile) writer(name string, mode writeMode) (*bytes.Buffer, error) {
+	switch mode {
+	case writeExclusive:
+		if f.body.Len() > 0 {
+			return nil, fmt.Errorf("output file %q already has content", name)
+		}
+	case writeReplace:
+		f.body.Reset()
+	}
+	return &f.body, nil
+}
+
+// render returns the content of f: its header, body and footer. Headers and
+// footers see HasData as true if the body is not empty. If pp is not nil, the
+// content is post-processed; errors from doing so name the templates that
+// wrote f.
+func (f *outputFile) render(tx *template.Template, name string, pp *postProcessor) (string, error) {
+	exec := func(w *bytes.Buffer, s *section) error {
+		data := s.data
+		if root, ok := data.(FlatTypeRoot); ok {
+			root.HasData = f.body.Len() > 0
+			data = root
+		}
+		return tx.ExecuteTemplate(w, s.name, data)
+	}
+
+	var buf bytes.Buffer
+	if f.header != nil {
+		if err := exec(&buf, f.header); err != nil {
+			return "", fmt.Errorf("header: %v", err)
+		}
+	}
+	buf.Write(f.body.Bytes())
+	if f.footer != nil {
+		if err := exec(&buf, f.footer); err != nil {
+			return "", fmt.Errorf("footer: %v", err)
+		}
+	}
+
+	if pp == nil {
+		return buf.String(), nil
+	}
+
+	content, err := pp.process(name, buf.Bytes())
+	if err != nil {
+		tpls := append([]string(nil), f.templates...)
+		for _, s := range []*section{f.header, f.footer} {
+			if s != nil {
+				tpls = append(tpls, s.name)
+			}
+		}
+		return "", fmt.Errorf("formatting output of template(s) %s: %v", strings.Join(tpls, ", "), err)
+	}
+	return string(content), nil
+}
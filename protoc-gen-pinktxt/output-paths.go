@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// outputPolicy normalizes and checks the names of generated files.
+type outputPolicy struct {
+	// allow is a list of directories and glob patterns (as understood by
+	// path.Match) that output names must fall under or match. If empty, any
+	// name inside the output root is allowed.
+	allow []string
+}
+
+func newOutputPolicy(allow []string) (*outputPolicy, error) {
+	p := &outputPolicy{}
+	for _, a := range allow {
+		if _, err := path.Match(a, ""); err != nil {
+			return nil, fmt.Errorf("invalid allow_out pattern %q: %v", a, err)
+		}
+		p.allow = append(p.allow, strings.TrimSuffix(path.Clean(a), "/"))
+	}
+	return p, nil
+}
+
+// check returns the normalized form of name. An error is returned if name is
+// absolute, escapes the output root, or is not allowed by the policy.
+func (p *outputPolicy) check(name string) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("output path is empty")
+	}
+
+	clean := path.Clean(strings.Replace(name, `\`, "/", -1))
+	switch {
+	case path.IsAbs(clean) || hasDrive(clean):
+		return "", fmt.Errorf("output path %q is absolute", name)
+	case clean == ".":
+		return "", fmt.Errorf("output path %q is not a file", name)
+	case clean == ".." || strings.HasPrefix(clean, "../"):
+		return "", fmt.Errorf("output path %q escapes the output root", name)
+	}
+
+	if !p.allowed(clean) {
+		return "", fmt.Errorf("output path %q is not in an allowed directory (allow_out=%s)", name, strings.Join(p.allow, ","))
+	}
+	return clean, nil
+}
+
+// hasDrive returns true if name, a cleaned path with forward slashes, is or
+// starts with a Windows drive root such as C:/. Other names containing colons
+// (e.g., a:b.txt) are valid relative names.
+func hasDrive(name string) bool {
+	if len(name) < 2 || name[1] != ':' || (len(name) > 2 && name[2] != '/') {
+		return false
+	}
+	c := name[0] | 0x20
+	return 'a' <= c && c <= 'z'
+}
+
+func (p *outputPolicy) allowed(name string) bool {
+	if len(p.allow) == 0 {
+		return true
+	}
+
+	for _, a := range p.allow {
+		if a == "." || name == a || strings.HasPrefix(name, a+"/") {
+			return true
+		}
+		if ok, _ := path.Match(a, name); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package main
+
+import "testing"
+
+func TestOutputPolicyCheck(t *testing.T) {
+	cases := []struct {
+		name string
+		want string
+	}{
+		{"a.txt", "a.txt"},
+		{"a/b.txt", "a/b.txt"},
+		{"a/./b", "a/b"},
+		{"./a/b", "a/b"},
+		{"a//b", "a/b"},
+		{"a/x/../b", "a/b"},
+		{`a\b.txt`, "a/b.txt"},
+		{`a\.\b`, "a/b"},
+		{"a/b/", "a/b"},
+		{"..foo", "..foo"},
+		// Colons only matter as part of a drive root.
+		{"a:b.txt", "a:b.txt"},
+		{"c:x", "c:x"},
+		{"ab:/x", "ab:/x"},
+		{"1:/x", "1:/x"},
+	}
+
+	p, err := newOutputPolicy(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range cases {
+		got, err := p.check(c.name)
+		if err != nil {
+			t.Errorf("check(%q) error = %v", c.name, err)
+		} else if got != c.want {
+			t.Errorf("check(%q) = %q; want %q", c.name, got, c.want)
+		}
+	}
+
+	for _, name := range []string{
+		"",
+		".",
+		"./",
+		"a/..",
+		"..",
+		"../x",
+		"a/../../x",
+		`..\x`,
+		`a\..\..\x`,
+		"/abs",
+		"/",
+		`\abs`,
+		`C:\x`,
+		"C:/x",
+		"c:/",
+		`z:\x`,
+	} {
+		if got, err := p.check(name); err == nil {
+			t.Errorf("check(%q) = %q; want error", name, got)
+		}
+	}
+}
+
+func TestOutputPolicyAllow(t *testing.T) {
+	p, err := newOutputPolicy([]string{"gen/", "docs/*.md", "README"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name string
+		want string
+	}{
+		{"gen/a.go", "gen/a.go"},
+		{"gen/sub/a.go", "gen/sub/a.go"},
+		{"./gen/a.go", "gen/a.go"},
+		{`gen\a.go`, "gen/a.go"},
+		{"other/../gen/a.go", "gen/a.go"},
+		{"docs/a.md", "docs/a.md"},
+		{"docs/./b.md", "docs/b.md"},
+		// Entries may also name single files.
+		{"README", "README"},
+	}
+	for _, c := range cases {
+		got, err := p.check(c.name)
+		if err != nil {
+			t.Errorf("check(%q) error = %v", c.name, err)
+		} else if got != c.want {
+			t.Errorf("check(%q) = %q; want %q", c.name, got, c.want)
+		}
+	}
+
+	for _, name := range []string{
+		"a.go",
+		"generated/a.go",
+		"gen/../a.go",
+		"docs/a.txt",
+		// Globs don't match across directories.
+		"docs/sub/a.md",
+		"../gen/a.go",
+	} {
+		if got, err := p.check(name); err == nil {
+			t.Errorf("check(%q) = %q; want error", name, got)
+		}
+	}
+
+	if _, err := newOutputPolicy([]string{"gen/["}); err == nil {
+		t.Errorf("newOutputPolicy(gen/[) succeeded; want error")
+	}
+}
+
+func TestOutputPathsShareFiles(t *testing.T) {
+	p, err := newOutputPolicy(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := newOutputFiles("", "")
+	var opened []*outputFile
+	for _, name := range []string{"a/b.txt", "a/./b.txt", `a\b.txt`, "a/x/../b.txt"} {
+		clean, err := p.check(name)
+		if err != nil {
+			t.Fatalf("check(%q) error = %v", name, err)
+		}
+		opened = append(opened, files.open(clean, nil))
+	}
+
+	for i, f := range opened[1:] {
+		if f != opened[0] {
+			t.Errorf("file %d is not the same file as file 0", i+1)
+		}
+	}
+	if len(files.files) != 1 {
+		t.Errorf("len(files) = %d; want 1", len(files.files))
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+)
+
+var outputVarRx = regexp.MustCompile(`\{\{\s*([a-z_]+)\s*\}\}`)
+
+// outputName expands the variables in an output name pattern for file. The
+// following variables are supported, shown for the file foo/bar/baz.proto
+// in package foo.bar:
+//
+//	{{file}}         foo/bar/baz.proto
+//	{{dir}}          foo/bar
+//	{{base}}         baz
+//	{{name}}         foo/bar/baz
+//	{{package}}      foo.bar
+//	{{package_dir}}  foo/bar
+func outputName(pattern string, file *desc.FileDescriptorProto) (string, error) {
+	name := file.GetName()
+	noext := strings.TrimSuffix(name, path.Ext(name))
+	vars := map[string]string{
+		"file":        name,
+		"dir":         path.Dir(name),
+		"base":        path.Base(noext),
+		"name":        noext,
+		"package":     file.GetPackage(),
+		"package_dir": strings.Replace(file.GetPackage(), ".", "/", -1),
+	}
+
+	var err error
+	out := outputVarRx.ReplaceAllStringFunc(pattern, func(m string) string {
+		key := outputVarRx.FindStringSubmatch(m)[1]
+		v, ok := vars[key]
+		if !ok && err == nil {
+			err = fmt.Errorf("output name %q: unknown variable %s", pattern, m)
+		}
+		return v
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Drop the leading ./ left by files without a directory.
+	return path.Clean(out), nil
+}
+
+// outputNames returns the output name pattern for each of templates. A single
+// pattern is used for all templates; otherwise there must be one pattern per
+// template.
+func outputNames(patterns, templates []string) ([]string, error) {
+	switch len(patterns) {
+	case 0:
+		return nil, fmt.Errorf("per_file requires an out pattern")
+	case 1:
+		out := make([]string, len(templates))
+		for i := range out {
+			out[i] = patterns[0]
+		}
+		return out, nil
+	case len(templates):
+		return patterns, nil
+	}
+	return nil, fmt.Errorf("got %d out patterns for %d templates", len(patterns), len(templates))
+}
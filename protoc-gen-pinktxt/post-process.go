@@ -0,0 +1,226 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// formatter post-processes the content of a generated file.
+type formatter func([]byte) ([]byte, error)
+
+// formatters maps file extensions to the formatter applied to files with that
+// extension. Formatted files are left as the formatter returns them.
+var formatters = map[string]formatter{
+	".go":   formatGo,
+	".json": formatJSON,
+	".yaml": validateYAML,
+	".yml":  validateYAML,
+}
+
+// postProcessor formats generated files and normalizes their whitespace by
+// extension.
+type postProcessor struct {
+	// format is the set of extensions to format. If empty, all extensions
+	// with a formatter are formatted. If nil, no files are formatted.
+	format map[string]bool
+	// normalize is the set of extensions to normalize the whitespace of. If
+	// empty, all files that aren't formatted are normalized. If nil, no files
+	// are normalized.
+	normalize map[string]bool
+}
+
+// newPostProcessor returns a postProcessor for the format and normalize
+// parameters, each of which is either a boolean or a list of extensions
+// (e.g., go,json). It returns nil if both are disabled.
+func newPostProcessor(params Params) *postProcessor {
+	p := &postProcessor{
+		format:    extensionSet(params["format"]),
+		normalize: extensionSet(params["normalize"]),
+	}
+	if p.format == nil && p.normalize == nil {
+		return nil
+	}
+	return p
+}
+
+// extensionSet returns the set of extensions in a parameter's values. It
+// returns an empty set if vals is true and nil if vals is empty or false.
+func extensionSet(vals []string) map[string]bool {
+	if len(vals) == 0 {
+		return nil
+	}
+	if len(vals) == 1 {
+		switch strings.ToLower(vals[0]) {
+		case "", "true", "yes", "all":
+			return map[string]bool{}
+		case "false", "no":
+			return nil
+		}
+	}
+
+	set := make(map[string]bool, len(vals))
+	for _, ext := range vals {
+		set["."+strings.TrimPrefix(strings.ToLower(ext), ".")] = true
+	}
+	return set
+}
+
+// hasExtension returns whether ext is in set, treating an empty set as
+// containing all extensions.
+func hasExtension(set map[string]bool, ext string) bool {
+	return set != nil && (len(set) == 0 || set[ext])
+}
+
+// process returns the formatted content of the file name. Whitespace is only
+// normalized for files that weren't formatted, since formatters already
+// produce the layout they want (and normalizing could change, e.g., Go raw
+// strings).
+func (p *postProcessor) process(name string, content []byte) ([]byte, error) {
+	ext := strings.ToLower(path.Ext(name))
+	if fn, ok := formatters[ext]; ok && hasExtension(p.format, ext) {
+		return fn(content)
+	}
+	if hasExtension(p.normalize, ext) {
+		return normalizeWhitespace(content), nil
+	}
+	return content, nil
+}
+
+// normalizeWhitespace removes trailing whitespace from each line and ensures
+// that non-empty content ends in exactly one newline.
+func normalizeWhitespace(content []byte) []byte {
+	lines := bytes.Split(content, []byte("\n"))
+	for i, l := range lines {
+		lines[i] = bytes.TrimRight(l, " \t\r")
+	}
+	for len(lines) > 0 && len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	return append(bytes.Join(lines, []byte("\n")), '\n')
+}
+
+func formatJSON(content []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, bytes.TrimSpace(content), "", "  "); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %v", err)
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+func validateYAML(content []byte) ([]byte, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		var v interface{}
+		err := dec.Decode(&v)
+		if err == io.EOF {
+			return content, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("invalid YAML: %v", err)
+		}
+	}
+}
+
+// formatGo formats Go source with go/format and groups its imports: standard
+// library imports first, then all others, each sorted by path.
+func formatGo(content []byte) ([]byte, error) {
+	// Imports are grouped first, since gofmt's own sorting of imports can
+	// separate them from their doc comments. Parse errors are left for
+	// go/format to report.
+	if grouped, err := groupImports(content); err == nil {
+		content = grouped
+	}
+	return format.Source(content)
+}
+
+func groupImports(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ImportsOnly|parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the first parenthesized import declaration is grouped.
+	var decl *ast.GenDecl
+	for _, d := range file.Decls {
+		if d, ok := d.(*ast.GenDecl); ok && d.Tok == token.IMPORT && d.Lparen.IsValid() {
+			decl = d
+			break
+		}
+	}
+	if decl == nil || len(decl.Specs) == 0 {
+		return src, nil
+	}
+
+	type spec struct {
+		path, text string
+	}
+	var std, other []spec
+	attached := 0
+	for _, s := range decl.Specs {
+		is := s.(*ast.ImportSpec)
+		start, end := is.Pos(), is.End()
+		if is.Doc != nil {
+			start = is.Doc.Pos()
+			attached++
+		}
+		if is.Comment != nil {
+			end = is.Comment.End()
+			attached++
+		}
+
+		p, _ := strconv.Unquote(is.Path.Value)
+		sp := spec{p, string(src[fset.Position(start).Offset:fset.Position(end).Offset])}
+		if first := strings.SplitN(p, "/", 2)[0]; strings.Contains(first, ".") {
+			other = append(other, sp)
+		} else {
+			std = append(std, sp)
+		}
+	}
+
+	// Leave the imports alone if moving them would lose a comment.
+	for _, c := range file.Comments {
+		if c.Pos() > decl.Lparen && c.End() < decl.Rparen {
+			attached--
+		}
+	}
+	if attached != 0 {
+		return src, nil
+	}
+
+	var b strings.Builder
+	for _, group := range [][]spec{std, other} {
+		if len(group) == 0 {
+			continue
+		}
+		sort.SliceStable(group, func(i, j int) bool { return group[i].path < group[j].path })
+		b.WriteString("\n")
+		for _, s := range group {
+			b.WriteString(s.text)
+			b.WriteString("\n")
+		}
+	}
+
+	lparen := fset.Position(decl.Lparen).Offset + 1
+	rparen := fset.Position(decl.Rparen).Offset
+	out := make([]byte, 0, len(src))
+	out = append(out, src[:lparen]...)
+	out = append(out, b.String()...)
+	out = append(out, src[rparen:]...)
+	return out, nil
+}
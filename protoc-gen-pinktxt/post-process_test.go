@@ -0,0 +1,259 @@
+package main
+
+import "testing"
+
+func TestFormatGoImports(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "groups",
+			in: `package p
+
+import (
+	"github.com/x/b"
+	"os"
+	"example.com/a"
+	"fmt"
+)
+`,
+			want: `package p
+
+import (
+	"fmt"
+	"os"
+
+	"example.com/a"
+	"github.com/x/b"
+)
+`,
+		},
+		{
+			name: "doc comments",
+			in: `package p
+
+import (
+	// B does things.
+	"github.com/x/b"
+	// Fmt is for printing.
+	"fmt"
+)
+`,
+			want: `package p
+
+import (
+	// Fmt is for printing.
+	"fmt"
+
+	// B does things.
+	"github.com/x/b"
+)
+`,
+		},
+		{
+			name: "trailing comments",
+			in: `package p
+
+import (
+	"github.com/x/b" // b
+	"os"             // os
+	"fmt"
+)
+`,
+			want: `package p
+
+import (
+	"fmt"
+	"os" // os
+
+	"github.com/x/b" // b
+)
+`,
+		},
+		{
+			name: "named imports",
+			in: `package p
+
+import (
+	yaml "gopkg.in/yaml.v2"
+	_ "embed"
+	a "github.com/x/z"
+	. "strings"
+)
+`,
+			want: `package p
+
+import (
+	_ "embed"
+	. "strings"
+
+	a "github.com/x/z"
+	yaml "gopkg.in/yaml.v2"
+)
+`,
+		},
+		{
+			// Only the first parenthesized declaration is grouped.
+			name: "multiple declarations",
+			in: `package p
+
+import "github.com/x/c"
+
+import (
+	"github.com/x/b"
+	"os"
+)
+
+import (
+	"github.com/x/a"
+	"fmt"
+)
+`,
+			want: `package p
+
+import "github.com/x/c"
+
+import (
+	"os"
+
+	"github.com/x/b"
+)
+
+import (
+	"fmt"
+	"github.com/x/a"
+)
+`,
+		},
+		{
+			// Floating comments can't be moved with an import, so the
+			// imports are only sorted by gofmt.
+			name: "floating comments",
+			in: `package p
+
+import (
+	"github.com/x/b"
+	"os"
+
+	// Floating.
+
+	"fmt"
+)
+`,
+			want: `package p
+
+import (
+	"github.com/x/b"
+	"os"
+
+	// Floating.
+
+	"fmt"
+)
+`,
+		},
+		{
+			name: "comment before rparen",
+			in: `package p
+
+import (
+	"github.com/x/b"
+	"fmt"
+	// Last.
+)
+`,
+			want: `package p
+
+import (
+	"fmt"
+	"github.com/x/b"
+	// Last.
+)
+`,
+		},
+	}
+
+	for _, c := range cases {
+		got, err := formatGo([]byte(c.in))
+		if err != nil {
+			t.Errorf("%s: formatGo() error = %v", c.name, err)
+		} else if string(got) != c.want {
+			t.Errorf("%s: formatGo() =\n%s\nwant:\n%s", c.name, got, c.want)
+		}
+	}
+}
+
+func TestGroupImportsUnchanged(t *testing.T) {
+	for _, src := range []string{
+		"package p\n",
+		"package p\n\nimport \"os\"\n",
+		"package p\n\nimport ()\n",
+		"package p\n\nimport (\n\t\"os\"\n\n\t// Floating.\n\n\t\"fmt\"\n)\n",
+		"package p\n\nimport (\n\t\"os\"\n\t/* x */ \"fmt\"\n)\n",
+	} {
+		got, err := groupImports([]byte(src))
+		if err != nil {
+			t.Errorf("groupImports(%q) error = %v", src, err)
+		} else if string(got) != src {
+			t.Errorf("groupImports(%q) = %q; want it unchanged", src, got)
+		}
+	}
+
+	if got, err := groupImports([]byte("package p\n\nimport (\n")); err == nil {
+		t.Errorf("groupImports(invalid) = %q; want error", got)
+	}
+}
+
+func TestPostProcess(t *testing.T) {
+	const (
+		goRaw   = "package p\n\nconst s = `a  \nb\t\n`\n"
+		md      = "line one  \nline two\n"
+		trailed = "a \t\r\nb  \n\n\n"
+	)
+
+	cases := []struct {
+		format, normalize []string
+		name, in, want    string
+	}{
+		// Formatted files aren't normalized.
+		{[]string{"true"}, []string{"true"}, "a.go", goRaw, goRaw},
+		{nil, []string{"true"}, "a.txt", trailed, "a\nb\n"},
+		{nil, []string{"true"}, "a.json", trailed, "a\nb\n"},
+		{[]string{"true"}, nil, "a.txt", trailed, trailed},
+		{[]string{"json"}, []string{"true"}, "a.go", goRaw, "package p\n\nconst s = `a\nb\n`\n"},
+		{[]string{"go"}, nil, "a.json", "{ }", "{ }"},
+		{[]string{".JSON"}, nil, "A.Json", `{"a":1}`, "{\n  \"a\": 1\n}\n"},
+		// Normalization is limited to the listed extensions.
+		{nil, []string{"txt"}, "a.md", md, md},
+		{nil, []string{"txt"}, "a.txt", md, "line one\nline two\n"},
+		{nil, []string{"txt", "md"}, "a.md", md, "line one\nline two\n"},
+		{nil, []string{"txt"}, "a.txt", " \n\n", ""},
+	}
+
+	for _, c := range cases {
+		params := Params{"format": c.format, "normalize": c.normalize}
+		p := newPostProcessor(params)
+		if p == nil {
+			t.Errorf("newPostProcessor(%v) = nil", params)
+			continue
+		}
+		got, err := p.process(c.name, []byte(c.in))
+		if err != nil {
+			t.Errorf("process(%v, %q) error = %v", params, c.name, err)
+		} else if string(got) != c.want {
+			t.Errorf("process(%v, %q) = %q; want %q", params, c.name, got, c.want)
+		}
+	}
+
+	for _, params := range []Params{
+		{},
+		{"format": {"false"}},
+		{"format": {"no"}, "normalize": {"false"}},
+	} {
+		if p := newPostProcessor(params); p != nil {
+			t.Errorf("newPostProcessor(%v) = %+v; want nil", params, p)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"text/template"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+)
+
+// Largest field and enum value numbers.
+const (
+	maxFieldNumber = 1<<29 - 1
+	maxEnumNumber  = math.MaxInt32
+)
+
+func mergeRanges(funcs template.FuncMap) template.FuncMap {
+	if funcs == nil {
+		funcs = make(template.FuncMap)
+	}
+
+	funcs["reserved_ranges"] = reservedRanges
+	funcs["reserved_names"] = reservedNames
+	funcs["extension_ranges"] = extensionRanges
+	funcs["is_reserved"] = isReserved
+	funcs["is_extension_number"] = isExtensionNumber
+	return funcs
+}
+
+// Range is an inclusive range of field or enum value numbers. Max is true if
+// End is the largest number allowed (i.e., the range was declared as
+// "N to max").
+type Range struct {
+	Start, End int32
+	Max        bool
+}
+
+// Contains returns true if n is in the range.
+func (r Range) Contains(n int32) bool {
+	return r.Start <= n && n <= r.End
+}
+
+// String returns the range as it would be written in a .proto file.
+func (r Range) String() string {
+	start := strconv.FormatInt(int64(r.Start), 10)
+	switch {
+	case r.Max:
+		return start + " to max"
+	case r.Start == r.End:
+		return start
+	}
+	return start + " to " + strconv.FormatInt(int64(r.End), 10)
+}
+
+// normalizeRanges sorts ranges and merges any that overlap or are adjacent.
+func normalizeRanges(ranges []Range) []Range {
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].Start < ranges[j].Start })
+	out := ranges[:1]
+	for _, r := range ranges[1:] {
+		last := &out[len(out)-1]
+		if int64(r.Start) > int64(last.End)+1 {
+			out = append(out, r)
+			continue
+		}
+		if r.End > last.End {
+			last.End = r.End
+		}
+		last.Max = last.Max || r.Max
+	}
+	return out
+}
+
+// messageRange returns the inclusive range of msg's end-exclusive start and
+// end numbers. Ranges of message sets aren't limited to maxFieldNumber: their
+// extensions may use any positive int32, and protoc stores "max" as an end of
+// math.MaxInt32.
+func messageRange(msg *desc.DescriptorProto, start, end int32) Range {
+	r := Range{Start: start, End: end - 1}
+	if msg.GetOptions().GetMessageSetWireFormat() {
+		r.Max = end == math.MaxInt32
+	} else if r.End >= maxFieldNumber {
+		r.End, r.Max = maxFieldNumber, true
+	}
+	return r
+}
+
+// reservedRanges returns the normalized reserved number ranges of a message
+// or enum.
+func reservedRanges(d interface{}) ([]Range, error) {
+	var ranges []Range
+	switch d := d.(type) {
+	case *desc.DescriptorProto:
+		for _, r := range d.GetReservedRange() {
+			ranges = append(ranges, messageRange(d, r.GetStart(), r.GetEnd()))
+		}
+	case *desc.EnumDescriptorProto:
+		// Enum ranges are already inclusive.
+		for _, r := range d.GetReservedRange() {
+			ranges = append(ranges, Range{Start: r.GetStart(), End: r.GetEnd(), Max: r.GetEnd() == maxEnumNumber})
+		}
+	default:
+		return nil, fmt.Errorf("reserved_ranges: %T has no reserved ranges", d)
+	}
+	return normalizeRanges(ranges), nil
+}
+
+// reservedNames returns the reserved names of a message or enum.
+func reservedNames(d interface{}) ([]string, error) {
+	switch d := d.(type) {
+	case *desc.DescriptorProto:
+		return d.GetReservedName(), nil
+	case *desc.EnumDescriptorProto:
+		return d.GetReservedName(), nil
+	}
+	return nil, fmt.Errorf("reserved_names: %T has no reserved names", d)
+}
+
+// extensionRanges returns the normalized extension ranges of a message.
+func extensionRanges(msg *desc.DescriptorProto) []Range {
+	var ranges []Range
+	for _, r := range msg.GetExtensionRange() {
+		ranges = append(ranges, messageRange(msg, r.GetStart(), r.GetEnd()))
+	}
+	return normalizeRanges(ranges)
+}
+
+// isReserved returns true if key, a number or name, is reserved in the
+// message or enum d.
+func isReserved(d interface{}, key interface{}) (bool, error) {
+	if name, ok := key.(string); ok {
+		names, err := reservedNames(d)
+		if err != nil {
+			return false, err
+		}
+		for _, n := range names {
+			if n == name {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	n, err := rangeNumber("is_reserved", key)
+	if err != nil {
+		return false, err
+	}
+	ranges, err := reservedRanges(d)
+	if err != nil {
+		return false, err
+	}
+	return inRanges(ranges, n), nil
+}
+
+// isExtensionNumber returns true if n is in one of msg's extension ranges.
+func isExtensionNumber(msg *desc.DescriptorProto, n interface{}) (bool, error) {
+	num, err := rangeNumber("is_extension_number", n)
+	if err != nil {
+		return false, err
+	}
+	return inRanges(extensionRanges(msg), num), nil
+}
+
+func inRanges(ranges []Range, n int32) bool {
+	for _, r := range ranges {
+		if r.Contains(n) {
+			return true
+		}
+	}
+	return false
+}
+
+// rangeNumber converts n, which may be any integer type, to an int32.
+func rangeNumber(fn string, n interface{}) (int32, error) {
+	var x int64
+	switch n := n.(type) {
+	case int:
+		x = int64(n)
+	case int32:
+		x = int64(n)
+	case int64:
+		x = n
+	case uint32:
+		x = int64(n)
+	case uint64:
+		if n > math.MaxInt32 {
+			return 0, fmt.Errorf("%s: number %d out of range", fn, n)
+		}
+		x = int64(n)
+	default:
+		return 0, fmt.Errorf("%s: %T is not a number or name", fn, n)
+	}
+	if x < math.MinInt32 || x > math.MaxInt32 {
+		return 0, fmt.Errorf("%s: number %d out of range", fn, x)
+	}
+	return int32(x), nil
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+)
+
+func TestNormalizeRanges(t *testing.T) {
+	cases := []struct {
+		in, want []Range
+	}{
+		{nil, nil},
+		{[]Range{{Start: 1, End: 1}}, []Range{{Start: 1, End: 1}}},
+		// Unsorted.
+		{
+			[]Range{{Start: 10, End: 12}, {Start: 1, End: 2}},
+			[]Range{{Start: 1, End: 2}, {Start: 10, End: 12}},
+		},
+		// Overlapping.
+		{
+			[]Range{{Start: 5, End: 10}, {Start: 1, End: 6}, {Start: 7, End: 8}},
+			[]Range{{Start: 1, End: 10}},
+		},
+		// Adjacent.
+		{
+			[]Range{{Start: 4, End: 6}, {Start: 1, End: 3}, {Start: 8, End: 9}},
+			[]Range{{Start: 1, End: 6}, {Start: 8, End: 9}},
+		},
+		{
+			[]Range{{Start: 100, End: maxFieldNumber, Max: true}, {Start: 50, End: 99}},
+			[]Range{{Start: 50, End: maxFieldNumber, Max: true}},
+		},
+		{
+			[]Range{{Start: math.MinInt32, End: -1}, {Start: 0, End: math.MaxInt32, Max: true}},
+			[]Range{{Start: math.MinInt32, End: math.MaxInt32, Max: true}},
+		},
+	}
+
+	for _, c := range cases {
+		in := append([]Range(nil), c.in...)
+		got := normalizeRanges(in)
+		if len(got) != len(c.want) {
+			t.Errorf("normalizeRanges(%v) = %v; want %v", c.in, got, c.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != c.want[i] {
+				t.Errorf("normalizeRanges(%v) = %+v; want %+v", c.in, got, c.want)
+				break
+			}
+		}
+	}
+}
+
+func TestMessageRange(t *testing.T) {
+	msg := &desc.DescriptorProto{}
+	cases := []struct {
+		start, end int32
+		want       Range
+		str        string
+	}{
+		{1, 2, Range{Start: 1, End: 1}, "1"},
+		{1, 10, Range{Start: 1, End: 9}, "1 to 9"},
+		{100, maxFieldNumber, Range{Start: 100, End: maxFieldNumber - 1}, "100 to 536870910"},
+		{100, maxFieldNumber + 1, Range{Start: 100, End: maxFieldNumber, Max: true}, "100 to max"},
+		// Ends past the largest field number are clamped.
+		{100, math.MaxInt32, Range{Start: 100, End: maxFieldNumber, Max: true}, "100 to max"},
+	}
+	for _, c := range cases {
+		got := messageRange(msg, c.start, c.end)
+		if got != c.want {
+			t.Errorf("messageRange(%d, %d) = %+v; want %+v", c.start, c.end, got, c.want)
+		}
+		if s := got.String(); s != c.str {
+			t.Errorf("messageRange(%d, %d).String() = %q; want %q", c.start, c.end, s, c.str)
+		}
+	}
+}
+
+func testReservedMessage() *desc.DescriptorProto {
+	return &desc.DescriptorProto{
+		Name: proto.String("M"),
+		ReservedRange: []*desc.DescriptorProto_ReservedRange{
+			{Start: proto.Int32(10), End: proto.Int32(20)},
+			{Start: proto.Int32(2), End: proto.Int32(3)},
+			{Start: proto.Int32(20), End: proto.Int32(21)},
+			{Start: proto.Int32(1000), End: proto.Int32(maxFieldNumber + 1)},
+		},
+		ReservedName: []string{"old", "gone"},
+		ExtensionRange: []*desc.DescriptorProto_ExtensionRange{
+			testExtensionRange(200, 300),
+			testExtensionRange(100, 200),
+		},
+	}
+}
+
+func testReservedEnum() *desc.EnumDescriptorProto {
+	return &desc.EnumDescriptorProto{
+		Name: proto.String("E"),
+		ReservedRange: []*desc.EnumDescriptorProto_EnumReservedRange{
+			{Start: proto.Int32(-5), End: proto.Int32(-1)},
+			{Start: proto.Int32(3), End: proto.Int32(3)},
+			{Start: proto.Int32(100), End: proto.Int32(math.MaxInt32)},
+		},
+		ReservedName: []string{"LEGACY"},
+	}
+}
+
+func TestReservedRanges(t *testing.T) {
+	cases := []struct {
+		d     interface{}
+		want  string
+		names []string
+	}{
+		{testReservedMessage(), "[2 10 to 20 1000 to max]", []string{"old", "gone"}},
+		// Enum ranges are inclusive.
+		{testReservedEnum(), "[-5 to -1 3 100 to max]", []string{"LEGACY"}},
+		{&desc.DescriptorProto{}, "[]", nil},
+	}
+
+	for _, c := range cases {
+		ranges, err := reservedRanges(c.d)
+		if err != nil {
+			t.Errorf("reservedRanges(%T) error = %v", c.d, err)
+		} else if got := fmt.Sprint(ranges); got != c.want {
+			t.Errorf("reservedRanges(%T) = %s; want %s", c.d, got, c.want)
+		}
+
+		names, err := reservedNames(c.d)
+		if err != nil || fmt.Sprint(names) != fmt.Sprint(c.names) {
+			t.Errorf("reservedNames(%T) = %v, %v; want %v", c.d, names, err, c.names)
+		}
+	}
+
+	for _, d := range []interface{}{nil, &desc.FieldDescriptorProto{}, "M"} {
+		if got, err := reservedRanges(d); err == nil {
+			t.Errorf("reservedRanges(%T) = %v; want error", d, got)
+		}
+		if got, err := reservedNames(d); err == nil {
+			t.Errorf("reservedNames(%T) = %v; want error", d, got)
+		}
+	}
+}
+
+func TestIsReserved(t *testing.T) {
+	msg, enum := testReservedMessage(), testReservedEnum()
+	cases := []struct {
+		d    interface{}
+		key  interface{}
+		want bool
+	}{
+		{msg, 2, true},
+		{msg, int32(1), false},
+		{msg, int64(20), true},
+		{msg, uint32(21), false},
+		{msg, uint64(maxFieldNumber), true},
+		{msg, 999, false},
+		{msg, "old", true},
+		{msg, "new", false},
+		{enum, -3, true},
+		{enum, 0, false},
+		{enum, int32(math.MaxInt32), true},
+		{enum, "LEGACY", true},
+		{enum, "old", false},
+	}
+
+	for _, c := range cases {
+		got, err := isReserved(c.d, c.key)
+		if err != nil {
+			t.Errorf("isReserved(%T, %v) error = %v", c.d, c.key, err)
+		} else if got != c.want {
+			t.Errorf("isReserved(%T, %v) = %t; want %t", c.d, c.key, got, c.want)
+		}
+	}
+
+	for _, c := range []struct {
+		d, key interface{}
+	}{
+		{msg, 1.5},
+		{msg, nil},
+		{msg, int64(math.MaxInt32) + 1},
+		{msg, int64(math.MinInt32) - 1},
+		{msg, uint64(math.MaxInt32) + 1},
+		{&desc.FieldDescriptorProto{}, 1},
+		{&desc.FieldDescriptorProto{}, "x"},
+	} {
+		if got, err := isReserved(c.d, c.key); err == nil {
+			t.Errorf("isReserved(%T, %v) = %t; want error", c.d, c.key, got)
+		}
+	}
+}
+
+func TestIsExtensionNumber(t *testing.T) {
+	msg := testReservedMessage()
+	if got, want := fmt.Sprint(extensionRanges(msg)), "[100 to 299]"; got != want {
+		t.Errorf("extensionRanges(M) = %s; want %s", got, want)
+	}
+
+	cases := []struct {
+		n    interface{}
+		want bool
+	}{
+		{99, false},
+		{100, true},
+		{int32(200), true},
+		{int64(299), true},
+		{uint32(300), false},
+		{uint64(150), true},
+	}
+	for _, c := range cases {
+		got, err := isExtensionNumber(msg, c.n)
+		if err != nil {
+			t.Errorf("isExtensionNumber(M, %v) error = %v", c.n, err)
+		} else if got != c.want {
+			t.Errorf("isExtensionNumber(M, %v) = %t; want %t", c.n, got, c.want)
+		}
+	}
+
+	for _, n := range []interface{}{"100", 1.0, uint64(1 << 40), int64(-1 << 40)} {
+		if got, err := isExtensionNumber(msg, n); err == nil {
+			t.Errorf("isExtensionNumber(M, %v) = %t; want error", n, got)
+		}
+	}
+}
+
+func testExtensionRange(start, end int32) *desc.DescriptorProto_ExtensionRange {
+	return &desc.DescriptorProto_ExtensionRange{Start: proto.Int32(start), End: proto.Int32(end)}
+}
+
+func TestMessageSetExtensionRanges(t *testing.T) {
+	msgSet := &desc.DescriptorProto{
+		Name:           proto.String("Set"),
+		ExtensionRange: []*desc.DescriptorProto_ExtensionRange{testExtensionRange(4, math.MaxInt32)},
+		Options:        &desc.MessageOptions{MessageSetWireFormat: proto.Bool(true)},
+	}
+
+	want := []Range{{Start: 4, End: math.MaxInt32 - 1, Max: true}}
+	if got := extensionRanges(msgSet); len(got) != 1 || got[0] != want[0] {
+		t.Errorf("extensionRanges(Set) = %v; want %v", got, want)
+	}
+	for _, n := range []int32{4, maxFieldNumber, maxFieldNumber + 1, math.MaxInt32 - 1} {
+		if ok, err := isExtensionNumber(msgSet, n); err != nil || !ok {
+			t.Errorf("isExtensionNumber(Set, %d) = %t, %v; want true", n, ok, err)
+		}
+	}
+}
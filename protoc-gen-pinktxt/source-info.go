@@ -0,0 +1,183 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"text/template"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+)
+
+// Field numbers of descriptor.proto used to build SourceCodeInfo paths.
+const (
+	pathFilePackage     = 2
+	pathFileMessageType = 4
+	pathFileEnumType    = 5
+	pathFileService     = 6
+	pathFileExtension   = 7
+	pathFileSyntax      = 12
+	pathFileEdition     = 14
+
+	pathMessageField      = 2
+	pathMessageNestedType = 3
+	pathMessageEnumType   = 4
+	pathMessageExtension  = 6
+	pathMessageOneofDecl  = 8
+
+	pathEnumValue = 2
+
+	pathServiceMethod = 2
+)
+
+func mergeSourceInfo(funcs template.FuncMap, types typeFinder) template.FuncMap {
+	if funcs == nil {
+		funcs = make(template.FuncMap)
+	}
+
+	funcs["comments"] = types.comments
+	funcs["leading_comments"] = func(d interface{}) string { return types.comments(d).Leading }
+	funcs["trailing_comments"] = func(d interface{}) string { return types.comments(d).Trailing }
+	funcs["detached_comments"] = func(d interface{}) []string { return types.comments(d).Detached }
+	funcs["location"] = types.location
+	return funcs
+}
+
+// appendPath returns a copy of path with elems appended to it.
+func appendPath(path []int32, elems ...int) []int32 {
+	p := make([]int32, len(path), len(path)+len(elems))
+	copy(p, path)
+	for _, e := range elems {
+		p = append(p, int32(e))
+	}
+	return p
+}
+
+func pathKey(path []int32) string {
+	var b strings.Builder
+	for i, p := range path {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.Itoa(int(p)))
+	}
+	return b.String()
+}
+
+// addLocations indexes the SourceCodeInfo locations of f by path. If more
+// than one location shares a path, the first is kept, since it spans the
+// entire declaration.
+func (s *symbolTable) addLocations(f *desc.FileDescriptorProto) {
+	locs := f.GetSourceCodeInfo().GetLocation()
+	if len(locs) == 0 {
+		return
+	}
+
+	index := make(map[string]*desc.SourceCodeInfo_Location, len(locs))
+	for _, loc := range locs {
+		key := pathKey(loc.GetPath())
+		if _, ok := index[key]; !ok {
+			index[key] = loc
+		}
+	}
+	s.locations[f] = index
+}
+
+// sourceLocation returns the SourceCodeInfo location of d, or nil if d has
+// no source info. The location of a file is that of its syntax or edition
+// statement, or its package statement if it has neither.
+func (t typeFinder) sourceLocation(d interface{}) *desc.SourceCodeInfo_Location {
+	d = unwrapOneof(d)
+	if !isHashable(d) {
+		return nil
+	}
+
+	file := t.fileOf(d)
+	index := t.symbols.locations[file]
+	if index == nil {
+		return nil
+	}
+
+	if d == interface{}(file) {
+		for _, path := range []int32{pathFileSyntax, pathFileEdition, pathFilePackage} {
+			if loc := index[pathKey([]int32{path})]; loc != nil {
+				return loc
+			}
+		}
+		return nil
+	}
+
+	path, ok := t.symbols.paths[d]
+	if !ok {
+		return nil
+	}
+	return index[pathKey(path)]
+}
+
+// Comments holds the comments attached to a descriptor in its source file.
+type Comments struct {
+	Leading  string
+	Trailing string
+	Detached []string
+}
+
+// String returns the leading and trailing comments of c, separated by a
+// newline if both are set.
+func (c Comments) String() string {
+	if c.Leading != "" && c.Trailing != "" {
+		return strings.TrimSuffix(c.Leading, "\n") + "\n" + c.Trailing
+	}
+	return c.Leading + c.Trailing
+}
+
+// comments returns the comments for d. Comment text is as protoc provides
+// it: comment markers are removed, but leading spaces and line breaks are
+// kept.
+func (t typeFinder) comments(d interface{}) Comments {
+	loc := t.sourceLocation(d)
+	return Comments{
+		Leading:  loc.GetLeadingComments(),
+		Trailing: loc.GetTrailingComments(),
+		Detached: loc.GetLeadingDetachedComments(),
+	}
+}
+
+// Location is the span of a descriptor in its source file. Lines and columns
+// are 1-based, unlike those of SourceCodeInfo.
+type Location struct {
+	File        string
+	StartLine   int
+	StartColumn int
+	EndLine     int
+	EndColumn   int
+}
+
+// String returns the location as file:line:column.
+func (l *Location) String() string {
+	if l == nil {
+		return ""
+	}
+	return l.File + ":" + strconv.Itoa(l.StartLine) + ":" + strconv.Itoa(l.StartColumn)
+}
+
+// location returns the source location of d, or nil if d has no source info.
+func (t typeFinder) location(d interface{}) *Location {
+	span := t.sourceLocation(d).GetSpan()
+	if len(span) != 3 && len(span) != 4 {
+		return nil
+	}
+
+	// Spans are either [start line, start column, end column] for spans on
+	// a single line or [start line, start column, end line, end column].
+	loc := &Location{
+		File:        t.fileOf(d).GetName(),
+		StartLine:   int(span[0]) + 1,
+		StartColumn: int(span[1]) + 1,
+		EndLine:     int(span[0]) + 1,
+		EndColumn:   int(span[2]) + 1,
+	}
+	if len(span) == 4 {
+		loc.EndLine = int(span[2]) + 1
+		loc.EndColumn = int(span[3]) + 1
+	}
+	return loc
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+	compiler "github.com/nilium/pinktxt/internal/plugin/google/protobuf/compiler"
+)
+
+// testLocation returns a location for path whose leading comment is name, so
+// that tests can tell which location a descriptor resolved to.
+func testLocation(name string, span []int32, path ...int32) *desc.SourceCodeInfo_Location {
+	return &desc.SourceCodeInfo_Location{
+		Path:            path,
+		Span:            span,
+		LeadingComments: proto.String(name),
+	}
+}
+
+func testSourceRequest() *compiler.CodeGeneratorRequest {
+	return &compiler.CodeGeneratorRequest{
+		ProtoFile: []*desc.FileDescriptorProto{
+			{
+				Name:    proto.String("src/a.proto"),
+				Package: proto.String("src"),
+				Syntax:  proto.String("proto3"),
+				MessageType: []*desc.DescriptorProto{
+					{Name: proto.String("First")},
+					{
+						Name: proto.String("Second"),
+						Field: []*desc.FieldDescriptorProto{
+							{Name: proto.String("a"), Number: proto.Int32(1)},
+							{Name: proto.String("b"), Number: proto.Int32(2), OneofIndex: proto.Int32(0)},
+						},
+						NestedType: []*desc.DescriptorProto{
+							{Name: proto.String("Nested")},
+						},
+						EnumType: []*desc.EnumDescriptorProto{
+							{
+								Name: proto.String("Kind"),
+								Value: []*desc.EnumValueDescriptorProto{
+									{Name: proto.String("KIND_A"), Number: proto.Int32(0)},
+									{Name: proto.String("KIND_B"), Number: proto.Int32(1)},
+								},
+							},
+						},
+						Extension: []*desc.FieldDescriptorProto{
+							{Name: proto.String("nested_ext"), Number: proto.Int32(100)},
+						},
+						OneofDecl: []*desc.OneofDescriptorProto{
+							{Name: proto.String("choice")},
+						},
+					},
+				},
+				EnumType: []*desc.EnumDescriptorProto{
+					{Name: proto.String("Top")},
+				},
+				Extension: []*desc.FieldDescriptorProto{
+					{Name: proto.String("top_ext"), Number: proto.Int32(101)},
+				},
+				Service: []*desc.ServiceDescriptorProto{
+					{
+						Name: proto.String("Svc"),
+						Method: []*desc.MethodDescriptorProto{
+							{Name: proto.String("Call")},
+							{Name: proto.String("Cast")},
+						},
+					},
+				},
+				SourceCodeInfo: &desc.SourceCodeInfo{
+					Location: []*desc.SourceCodeInfo_Location{
+						testLocation("file", nil),
+						testLocation("syntax", nil, 12),
+						testLocation("package", nil, 2),
+						testLocation("First", nil, 4, 0),
+						testLocation("First name", nil, 4, 0, 1),
+						testLocation("Second", nil, 4, 1),
+						// A second location with the same path, as protoc emits
+						// for some declarations, must not replace the first.
+						testLocation("Second again", nil, 4, 1),
+						testLocation("a", nil, 4, 1, 2, 0),
+						testLocation("b", nil, 4, 1, 2, 1),
+						testLocation("Nested", nil, 4, 1, 3, 0),
+						testLocation("Kind", nil, 4, 1, 4, 0),
+						testLocation("KIND_B", nil, 4, 1, 4, 0, 2, 1),
+						testLocation("nested_ext", nil, 4, 1, 6, 0),
+						testLocation("choice", nil, 4, 1, 8, 0),
+						testLocation("Top", nil, 5, 0),
+						testLocation("Svc", nil, 6, 0),
+						testLocation("Cast", nil, 6, 0, 2, 1),
+						testLocation("top_ext", nil, 7, 0),
+					},
+				},
+			},
+			{
+				Name:    proto.String("src/editions.proto"),
+				Package: proto.String("src.ed"),
+				Syntax:  proto.String("editions"),
+				Edition: desc.Edition_EDITION_2023.Enum(),
+				SourceCodeInfo: &desc.SourceCodeInfo{
+					Location: []*desc.SourceCodeInfo_Location{
+						testLocation("package", nil, 2),
+						testLocation("edition", nil, 14),
+					},
+				},
+			},
+			{
+				Name:    proto.String("src/proto2.proto"),
+				Package: proto.String("src.p2"),
+				SourceCodeInfo: &desc.SourceCodeInfo{
+					Location: []*desc.SourceCodeInfo_Location{
+						testLocation("package", nil, 2),
+					},
+				},
+			},
+			{
+				Name:    proto.String("src/nosource.proto"),
+				Package: proto.String("src.none"),
+				MessageType: []*desc.DescriptorProto{
+					{Name: proto.String("Bare")},
+				},
+			},
+		},
+	}
+}
+
+func TestSourceInfoComments(t *testing.T) {
+	req := testSourceRequest()
+	a, ed, p2, none := req.ProtoFile[0], req.ProtoFile[1], req.ProtoFile[2], req.ProtoFile[3]
+	second := a.MessageType[1]
+	kind := second.EnumType[0]
+	svc := a.Service[0]
+
+	cases := []struct {
+		d    interface{}
+		want string
+	}{
+		{a, "syntax"},
+		{ed, "edition"},
+		{p2, "package"},
+		{a.MessageType[0], "First"},
+		{second, "Second"},
+		{second.Field[0], "a"},
+		{second.Field[1], "b"},
+		{second.NestedType[0], "Nested"},
+		{kind, "Kind"},
+		{kind.Value[0], ""},
+		{kind.Value[1], "KIND_B"},
+		{second.Extension[0], "nested_ext"},
+		{second.OneofDecl[0], "choice"},
+		{a.EnumType[0], "Top"},
+		{svc, "Svc"},
+		{svc.Method[0], ""},
+		{svc.Method[1], "Cast"},
+		{a.Extension[0], "top_ext"},
+		{none, ""},
+		{none.MessageType[0], ""},
+		{&desc.DescriptorProto{}, ""},
+		{nil, ""},
+		{"src.First", ""},
+	}
+
+	finder := newTypeFinder(req)
+	for _, c := range cases {
+		if got := finder.comments(c.d).Leading; got != c.want {
+			t.Errorf("comments(%v).Leading = %q; want %q", c.d, got, c.want)
+		}
+	}
+}
+
+func TestAppendPath(t *testing.T) {
+	base := []int32{4, 1}
+	got := appendPath(base, 2, 0)
+	if want := []int32{4, 1, 2, 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("appendPath(%v, 2, 0) = %v; want %v", base, got, want)
+	}
+
+	// Appending to the same prefix twice must not share storage.
+	other := appendPath(base, 3)
+	if got[2] != 2 || !reflect.DeepEqual(other, []int32{4, 1, 3}) {
+		t.Errorf("appendPath shares storage: %v, %v", got, other)
+	}
+	if !reflect.DeepEqual(base, []int32{4, 1}) {
+		t.Errorf("appendPath modified its input: %v", base)
+	}
+}
+
+func TestPathKey(t *testing.T) {
+	cases := []struct {
+		path []int32
+		want string
+	}{
+		{nil, ""},
+		{[]int32{12}, "12"},
+		{[]int32{4, 0, 2, 10}, "4,0,2,10"},
+		// Keys must not collide when elements have more than one digit.
+		{[]int32{4, 12}, "4,12"},
+		{[]int32{41, 2}, "41,2"},
+	}
+
+	for _, c := range cases {
+		if got := pathKey(c.path); got != c.want {
+			t.Errorf("pathKey(%v) = %q; want %q", c.path, got, c.want)
+		}
+	}
+}
+
+func TestSourceInfoLocation(t *testing.T) {
+	file := &desc.FileDescriptorProto{
+		Name:    proto.String("src/span.proto"),
+		Package: proto.String("src.span"),
+		MessageType: []*desc.DescriptorProto{
+			{Name: proto.String("SingleLine")},
+			{Name: proto.String("MultiLine")},
+			{Name: proto.String("BadSpan")},
+			{Name: proto.String("NoSpan")},
+		},
+		SourceCodeInfo: &desc.SourceCodeInfo{
+			Location: []*desc.SourceCodeInfo_Location{
+				testLocation("package", []int32{1, 0, 17}, 2),
+				testLocation("SingleLine", []int32{3, 2, 24}, 4, 0),
+				testLocation("MultiLine", []int32{5, 0, 9, 1}, 4, 1),
+				testLocation("BadSpan", []int32{5, 0}, 4, 2),
+			},
+		},
+	}
+	req := &compiler.CodeGeneratorRequest{ProtoFile: []*desc.FileDescriptorProto{file}}
+
+	cases := []struct {
+		d    interface{}
+		want *Location
+	}{
+		{file, &Location{"src/span.proto", 2, 1, 2, 18}},
+		{file.MessageType[0], &Location{"src/span.proto", 4, 3, 4, 25}},
+		{file.MessageType[1], &Location{"src/span.proto", 6, 1, 10, 2}},
+		{file.MessageType[2], nil},
+		{file.MessageType[3], nil},
+		{&desc.DescriptorProto{}, nil},
+	}
+
+	finder := newTypeFinder(req)
+	for _, c := range cases {
+		got := finder.location(c.d)
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("location(%v) = %+v; want %+v", c.d, got, c.want)
+		}
+	}
+
+	if got, want := finder.location(file.MessageType[1]).String(), "src/span.proto:6:1"; got != want {
+		t.Errorf("location(MultiLine).String() = %q; want %q", got, want)
+	}
+	if got := (*Location)(nil).String(); got != "" {
+		t.Errorf("(*Location)(nil).String() = %q; want empty", got)
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+)
+
+// standardOption returns the value of the option name, as declared in
+// descriptor.proto (e.g., go_package or deprecated), in d's options. If the
+// option is not set, its default value is returned. Repeated options are
+// returned as slices.
+func standardOption(name string, d interface{}) (interface{}, error) {
+	opts, typeName := optionsOf(d)
+	if opts == nil {
+		return nil, fmt.Errorf("option %s: %T has no options", name, d)
+	}
+
+	v := reflect.ValueOf(opts)
+	st := v.Type().Elem()
+	for i := 0; i < st.NumField(); i++ {
+		f := st.Field(i)
+		if !hasProtobufName(f.Tag.Get("protobuf"), name) {
+			continue
+		}
+
+		// Getters handle nil options and apply the option's default.
+		if get := v.MethodByName("Get" + f.Name); get.IsValid() {
+			return get.Call(nil)[0].Interface(), nil
+		}
+		break
+	}
+
+	return nil, fmt.Errorf("option %s: not an option of %s", name, typeName[1:])
+}
+
+// hasProtobufName returns true if the protobuf struct tag has the given
+// field name.
+func hasProtobufName(tag, name string) bool {
+	for _, part := range strings.Split(tag, ",") {
+		if part == "name="+name {
+			return true
+		}
+	}
+	return false
+}
+
+// standardOptionOf returns a template function, named fn, that returns a
+// standard option of descriptors of type want only.
+func standardOptionOf(fn string, want reflect.Type) func(string, interface{}) (interface{}, error) {
+	return func(name string, d interface{}) (interface{}, error) {
+		d = unwrapOneof(d)
+		if reflect.TypeOf(d) != want {
+			return nil, fmt.Errorf("%s %s: expected %v, got %T", fn, name, want, d)
+		}
+		return standardOption(name, d)
+	}
+}
+
+func isDeprecated(d interface{}) bool {
+	if _, ok := d.(*desc.OneofDescriptorProto); ok {
+		// Oneofs have no deprecated option.
+		return false
+	}
+	v, err := standardOption("deprecated", d)
+	return err == nil && v == true
+}
+
+func isLazy(d interface{}) bool {
+	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil {
+		return p.GetOptions().GetLazy() || p.GetOptions().GetUnverifiedLazy()
+	}
+
+	return false
+}
+
+// jsonName returns the JSON name of field d. protoc always sets json_name for
+// plugins, but if it's missing the default is computed from the field name.
+func jsonName(d *desc.FieldDescriptorProto) string {
+	if d.JsonName != nil {
+		return d.GetJsonName()
+	}
+
+	upNext := false
+	return strings.Map(func(r rune) rune {
+		if r == '_' {
+			upNext = true
+			return -1
+		}
+		if upNext {
+			upNext = false
+			return unicode.ToUpper(r)
+		}
+		return r
+	}, d.GetName())
+}
+
+// isPacked returns true if the repeated field d uses packed encoding. An
+// explicit packed option takes precedence, followed by the
+// repeated_field_encoding feature of d or its enclosing scopes, and
+// then the default for the file's syntax.
+func (t typeFinder) isPacked(d interface{}) bool {
+	p, ok := d.(*desc.FieldDescriptorProto)
+	if !ok || p == nil || p.GetLabel() != desc.FieldDescriptorProto_LABEL_REPEATED || !isPackable(p.GetType()) {
+		return false
+	}
+
+	if opts := p.GetOptions(); opts != nil && opts.Packed != nil {
+		return opts.GetPacked()
+	}
+
+	for d := interface{}(p); d != nil; d = t.parent(d) {
+		enc := t.features(d).GetRepeatedFieldEncoding()
+		if enc != desc.FeatureSet_REPEATED_FIELD_ENCODING_UNKNOWN {
+			return enc == desc.FeatureSet_PACKED
+		}
+	}
+
+	switch t.fileOf(p).GetSyntax() {
+	case "proto3", "editions":
+		return true
+	}
+	return false
+}
+
+// fieldPresence returns the field_presence feature in effect for field,
+// resolved through the field, its parents, and its file. Files that don't
+// declare features get the presence implied by their syntax. Message and group
+// fields always have explicit presence unless they're required.
+func (t typeFinder) fieldPresence(field *desc.FieldDescriptorProto) desc.FeatureSet_FieldPresence {
+	if field.GetLabel() == desc.FieldDescriptorProto_LABEL_REQUIRED ||
+		t.features(field).GetFieldPresence() == desc.FeatureSet_LEGACY_REQUIRED {
+		return desc.FeatureSet_LEGACY_REQUIRED
+	}
+	switch field.GetType() {
+	case desc.FieldDescriptorProto_TYPE_MESSAGE, desc.FieldDescriptorProto_TYPE_GROUP:
+		return desc.FeatureSet_EXPLICIT
+	}
+
+	for d := interface{}(field); d != nil; d = t.parent(d) {
+		if p := t.features(d).GetFieldPresence(); p != desc.FeatureSet_FIELD_PRESENCE_UNKNOWN {
+			return p
+		}
+	}
+
+	if t.fileOf(field).GetSyntax() == "proto3" && !field.GetProto3Optional() {
+		return desc.FeatureSet_IMPLICIT
+	}
+	return desc.FeatureSet_EXPLICIT
+}
+
+// features returns the feature set declared in d's options, if any.
+func (t typeFinder) features(d interface{}) *desc.FeatureSet {
+	opts, _ := optionsOf(d)
+	if f, ok := opts.(interface{ GetFeatures() *desc.FeatureSet }); ok {
+		return f.GetFeatures()
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+	compiler "github.com/nilium/pinktxt/internal/plugin/google/protobuf/compiler"
+)
+
+func testPresenceField(name string, label desc.FieldDescriptorProto_Label, presence desc.FeatureSet_FieldPresence) *desc.FieldDescriptorProto {
+	f := &desc.FieldDescriptorProto{
+		Name:   proto.String(name),
+		Number: proto.Int32(1),
+		Label:  label.Enum(),
+		Type:   desc.FieldDescriptorProto_TYPE_INT32.Enum(),
+	}
+	if presence != desc.FeatureSet_FIELD_PRESENCE_UNKNOWN {
+		f.Options = &desc.FieldOptions{
+			Features: &desc.FeatureSet{FieldPresence: presence.Enum()},
+		}
+	}
+	return f
+}
+
+func testPresenceFile(name, syntax string, presence desc.FeatureSet_FieldPresence, fields ...*desc.FieldDescriptorProto) *desc.FileDescriptorProto {
+	f := &desc.FileDescriptorProto{
+		Name:    proto.String(name),
+		Package: proto.String("presence"),
+		MessageType: []*desc.DescriptorProto{
+			{Name: proto.String("M" + syntax), Field: fields},
+		},
+	}
+	if syntax != "proto2" {
+		f.Syntax = proto.String(syntax)
+	}
+	if syntax == "editions" {
+		f.Edition = desc.Edition_EDITION_2023.Enum()
+	}
+	if presence != desc.FeatureSet_FIELD_PRESENCE_UNKNOWN {
+		f.Options = &desc.FileOptions{
+			Features: &desc.FeatureSet{FieldPresence: presence.Enum()},
+		}
+	}
+	return f
+}
+
+func TestFieldPresence(t *testing.T) {
+	const (
+		unset    = desc.FeatureSet_FIELD_PRESENCE_UNKNOWN
+		explicit = desc.FeatureSet_EXPLICIT
+		implicit = desc.FeatureSet_IMPLICIT
+		required = desc.FeatureSet_LEGACY_REQUIRED
+
+		optional = desc.FieldDescriptorProto_LABEL_OPTIONAL
+		repeated = desc.FieldDescriptorProto_LABEL_REPEATED
+		labelReq = desc.FieldDescriptorProto_LABEL_REQUIRED
+
+		tMessage = desc.FieldDescriptorProto_TYPE_MESSAGE
+	)
+
+	p2opt := testPresenceField("p2opt", optional, unset)
+	p2req := testPresenceField("p2req", labelReq, unset)
+	p3plain := testPresenceField("p3plain", optional, unset)
+	p3opt := testPresenceField("p3opt", optional, unset)
+	p3opt.Proto3Optional = proto.Bool(true)
+	edPlain := testPresenceField("ed_plain", optional, unset)
+	edReq := testPresenceField("ed_req", optional, required)
+	edImplicit := testPresenceField("ed_implicit", optional, implicit)
+	edList := testPresenceField("ed_list", repeated, unset)
+	fileImplicit := testPresenceField("file_implicit", optional, unset)
+	fileOverride := testPresenceField("file_override", optional, explicit)
+	p3msg := testPresenceField("p3msg", optional, unset)
+	p3msg.Type = tMessage.Enum()
+	edMsg := testPresenceField("ed_msg", optional, unset)
+	edMsg.Type = tMessage.Enum()
+	edMsgImplicit := testPresenceField("ed_msg_implicit", optional, implicit)
+	edMsgImplicit.Type = tMessage.Enum()
+	edMsgReq := testPresenceField("ed_msg_req", optional, required)
+	edMsgReq.Type = tMessage.Enum()
+	edGroup := testPresenceField("ed_group", optional, unset)
+	edGroup.Type = desc.FieldDescriptorProto_TYPE_GROUP.Enum()
+
+	req := &compiler.CodeGeneratorRequest{
+		ProtoFile: []*desc.FileDescriptorProto{
+			testPresenceFile("p2.proto", "proto2", unset, p2opt, p2req),
+			testPresenceFile("p3.proto", "proto3", unset, p3plain, p3opt, p3msg),
+			testPresenceFile("ed.proto", "editions", unset, edPlain, edReq, edImplicit, edList),
+			testPresenceFile("edimp.proto", "editions", implicit, fileImplicit, fileOverride, edMsg, edMsgImplicit, edMsgReq, edGroup),
+		},
+	}
+
+	cases := []struct {
+		field    *desc.FieldDescriptorProto
+		presence desc.FeatureSet_FieldPresence
+		label    string
+	}{
+		{p2opt, explicit, "optional"},
+		{p2req, required, "required"},
+		{p3plain, implicit, "optional"},
+		{p3opt, explicit, "optional"},
+		{edPlain, explicit, "optional"},
+		{edReq, required, "required"},
+		{edImplicit, implicit, "optional"},
+		{edList, explicit, "repeated"},
+		{fileImplicit, implicit, "optional"},
+		{fileOverride, explicit, "optional"},
+		// Message fields always have explicit presence.
+		{p3msg, explicit, "optional"},
+		{edMsg, explicit, "optional"},
+		{edMsgImplicit, explicit, "optional"},
+		{edMsgReq, required, "required"},
+		{edGroup, explicit, "optional"},
+	}
+
+	finder := newTypeFinder(req)
+	isRequired := finder.labelCheck(labelReq)
+	isOptional := finder.labelCheck(optional)
+	for _, c := range cases {
+		name := c.field.GetName()
+		if got := finder.fieldPresence(c.field); got != c.presence {
+			t.Errorf("fieldPresence(%s) = %v; want %v", name, got, c.presence)
+		}
+		if got, err := finder.label(c.field); err != nil || got != c.label {
+			t.Errorf("label(%s) = %q, %v; want %q", name, got, err, c.label)
+		}
+		if got, want := isRequired(c.field), c.label == "required"; got != want {
+			t.Errorf("is_required(%s) = %t; want %t", name, got, want)
+		}
+		if got, want := isOptional(c.field), c.label == "optional"; got != want {
+			t.Errorf("is_optional(%s) = %t; want %t", name, got, want)
+		}
+	}
+}
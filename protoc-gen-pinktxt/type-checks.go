@@ -1,20 +1,45 @@
 package main
 
 import (
+	"fmt"
+	"strings"
 	"text/template"
 
 	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
 )
 
-func mergeTypeChecks(funcs template.FuncMap) template.FuncMap {
+func mergeTypeChecks(funcs template.FuncMap, types typeFinder) template.FuncMap {
 	if funcs == nil {
 		funcs = make(template.FuncMap)
 	}
 
+	funcs["is_map"] = types.isMap
+	funcs["map_key"] = types.mapKey
+	funcs["map_value"] = types.mapValue
+	funcs["method_input"] = types.methodInput
+	funcs["method_output"] = types.methodOutput
+	funcs["is_client_streaming"] = isClientStreaming
+	funcs["is_server_streaming"] = isServerStreaming
+	funcs["is_bidi_streaming"] = isBidiStreaming
+	funcs["is_wkt"] = types.isWKT
+	funcs["is_any"] = types.isWKTNamed("Any")
+	funcs["is_duration"] = types.isWKTNamed("Duration")
+	funcs["is_empty"] = types.isWKTNamed("Empty")
+	funcs["is_field_mask"] = types.isWKTNamed("FieldMask")
+	funcs["is_struct"] = types.isWKTNamed("Struct")
+	funcs["is_value"] = types.isWKTNamed("Value")
+	funcs["is_list_value"] = types.isWKTNamed("ListValue")
+	funcs["is_null_value"] = types.isWKTNamed("NullValue")
+	funcs["is_timestamp"] = types.isWKTNamed("Timestamp")
+	funcs["is_wrapper"] = types.isWrapper
+	funcs["wrapper_inner_type"] = types.wrapperInnerType
+
 	funcs["is_oneof"] = isOneOf
+	funcs["is_proto3_optional"] = isProto3Optional
+	funcs["is_synthetic_oneof"] = isSyntheticOneof
 	funcs["is_repeated"] = isRepeated
-	funcs["is_optional"] = isOptional
-	funcs["is_required"] = isRequired
+	funcs["is_optional"] = types.labelCheck(desc.FieldDescriptorProto_LABEL_OPTIONAL)
+	funcs["is_required"] = types.labelCheck(desc.FieldDescriptorProto_LABEL_REQUIRED)
 	funcs["is_double"] = isDouble
 	funcs["is_float"] = isFloat
 	funcs["is_int64"] = isInt64
@@ -33,266 +58,349 @@ func mergeTypeChecks(funcs template.FuncMap) template.FuncMap {
 	funcs["is_sfixed64"] = isSfixed64
 	funcs["is_sint32"] = isSint32
 	funcs["is_sint64"] = isSint64
+
+	funcs["kind"] = kind
+	funcs["label"] = types.label
+	funcs["wire_type"] = types.wireType
+	funcs["is_scalar"] = isScalar
+	funcs["is_numeric"] = isNumeric
+	funcs["is_integer"] = isInteger
+	funcs["is_signed"] = isSigned
+	funcs["is_floating"] = isFloating
+	funcs["is_varint"] = isVarint
+	funcs["is_fixed_width"] = isFixedWidth
+	funcs["is_zigzag"] = isZigzag
 	return funcs
 }
 
-func isRepeated(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetLabel()
-	}
-
-	if i, ok := d.(desc.FieldDescriptorProto_Label); ok {
-		return i == desc.FieldDescriptorProto_LABEL_REPEATED
-	}
-
-	return false
+// mapEntry returns the synthetic map entry message for d, which may be either
+// a map field or the entry message itself. If d is not a map, it returns nil.
+func (t typeFinder) mapEntry(d interface{}) *desc.DescriptorProto {
+	switch p := d.(type) {
+	case *desc.DescriptorProto:
+		if p.GetOptions().GetMapEntry() {
+			return p
+		}
+	case *desc.FieldDescriptorProto:
+		if p.GetLabel() != desc.FieldDescriptorProto_LABEL_REPEATED ||
+			p.GetType() != desc.FieldDescriptorProto_TYPE_MESSAGE {
+			return nil
+		}
+		if m, ok := t.Find(p.GetTypeName()).(*desc.DescriptorProto); ok && m.GetOptions().GetMapEntry() {
+			return m
+		}
+	}
+	return nil
 }
 
-func isOneOf(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		return p.OneofIndex != nil
+func (t typeFinder) mapEntryField(d interface{}, number int32) *desc.FieldDescriptorProto {
+	for _, f := range t.mapEntry(d).GetField() {
+		if f.GetNumber() == number {
+			return f
+		}
 	}
-
-	return false
+	return nil
 }
 
-func isOptional(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetLabel()
-	}
-
-	if i, ok := d.(desc.FieldDescriptorProto_Label); ok {
-		return i == desc.FieldDescriptorProto_LABEL_OPTIONAL
-	}
-
-	return false
+func (t typeFinder) isMap(d interface{}) bool {
+	return t.mapEntry(d) != nil
 }
 
-func isRequired(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetLabel()
-	}
-
-	if i, ok := d.(desc.FieldDescriptorProto_Label); ok {
-		return i == desc.FieldDescriptorProto_LABEL_REQUIRED
-	}
-
-	return false
+// mapKey returns the key field of the map field or entry message d.
+func (t typeFinder) mapKey(d interface{}) *desc.FieldDescriptorProto {
+	return t.mapEntryField(d, 1)
 }
 
-func isDouble(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
-	}
-
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_DOUBLE
-	}
-
-	return false
+// mapValue returns the value field of the map field or entry message d.
+func (t typeFinder) mapValue(d interface{}) *desc.FieldDescriptorProto {
+	return t.mapEntryField(d, 2)
 }
 
-func isFloat(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
-	}
-
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_FLOAT
-	}
-
-	return false
+// methodInput returns the message descriptor of method's input type.
+func (t typeFinder) methodInput(method *desc.MethodDescriptorProto) (*desc.DescriptorProto, error) {
+	return t.findMessage(method.GetInputType())
 }
 
-func isInt64(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
-	}
-
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_INT64
-	}
-
-	return false
+// methodOutput returns the message descriptor of method's output type.
+func (t typeFinder) methodOutput(method *desc.MethodDescriptorProto) (*desc.DescriptorProto, error) {
+	return t.findMessage(method.GetOutputType())
 }
 
-func isUint64(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
+func (t typeFinder) findMessage(name string) (*desc.DescriptorProto, error) {
+	if m, ok := t.Find(name).(*desc.DescriptorProto); ok {
+		return m, nil
 	}
+	return nil, fmt.Errorf("message type %q not found", name)
+}
 
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_UINT64
+func isClientStreaming(d interface{}) bool {
+	if p, ok := d.(*desc.MethodDescriptorProto); ok && p != nil {
+		return p.GetClientStreaming()
 	}
 
 	return false
 }
 
-func isInt32(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
-	}
-
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_INT32
+func isServerStreaming(d interface{}) bool {
+	if p, ok := d.(*desc.MethodDescriptorProto); ok && p != nil {
+		return p.GetServerStreaming()
 	}
 
 	return false
 }
 
-func isFixed64(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
-	}
-
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_FIXED64
-	}
-
-	return false
+func isBidiStreaming(d interface{}) bool {
+	return isClientStreaming(d) && isServerStreaming(d)
 }
 
-func isFixed32(d interface{}) bool {
+// isOneOf returns true if d is a field belonging to a real oneof. Fields
+// declared with proto3 optional are members of a synthetic oneof and are not
+// considered oneof fields (see isProto3Optional).
+func isOneOf(d interface{}) bool {
 	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
-	}
-
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_FIXED32
+		return p.OneofIndex != nil && !p.GetProto3Optional()
 	}
 
 	return false
 }
 
-func isBool(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
-	}
-
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_BOOL
+func isProto3Optional(d interface{}) bool {
+	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil {
+		return p.GetProto3Optional()
 	}
 
 	return false
 }
 
-func isString(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
-	}
-
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_STRING
+// isSyntheticOneof returns true if oneof, either a oneof descriptor or an
+// index into msg's oneof declarations, is a synthetic oneof generated by
+// protoc for a proto3 optional field of msg.
+func isSyntheticOneof(msg *desc.DescriptorProto, oneof interface{}) bool {
+	index := -1
+	switch o := unwrapOneof(oneof).(type) {
+	case int:
+		index = o
+	case int32:
+		index = int(o)
+	case *desc.OneofDescriptorProto:
+		for i, d := range msg.GetOneofDecl() {
+			if d == o {
+				index = i
+				break
+			}
+		}
 	}
 
-	return false
-}
-
-func isGroup(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
+	if index < 0 {
+		return false
 	}
 
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_GROUP
+	for _, f := range msg.GetField() {
+		if f.OneofIndex != nil && int(f.GetOneofIndex()) == index {
+			return f.GetProto3Optional()
+		}
 	}
 
 	return false
 }
 
-func isMessage(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
-	}
-
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_MESSAGE
-	}
-	_, ok := d.(*desc.DescriptorProto)
-	return ok
+// fieldType returns the field type of d, which may be a field, a field type,
+// a type name as written in .proto files (e.g., sint32), or a message or enum
+// descriptor. If d has no field type, ok is false.
+func fieldType(d interface{}) (typ desc.FieldDescriptorProto_Type, ok bool) {
+	switch p := d.(type) {
+	case *desc.FieldDescriptorProto:
+		if p != nil && p.Type != nil {
+			return p.GetType(), true
+		}
+	case desc.FieldDescriptorProto_Type:
+		return p, true
+	case *desc.DescriptorProto:
+		return desc.FieldDescriptorProto_TYPE_MESSAGE, p != nil
+	case *desc.EnumDescriptorProto:
+		return desc.FieldDescriptorProto_TYPE_ENUM, p != nil
+	case string:
+		v, ok := desc.FieldDescriptorProto_Type_value["TYPE_"+strings.ToUpper(p)]
+		return desc.FieldDescriptorProto_Type(v), ok
+	}
+	return 0, false
 }
 
-func isBytes(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
-	}
-
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_BYTES
-	}
-
-	return false
+// fieldLabel returns the label of d, which may be a field or a field label.
+func fieldLabel(d interface{}) (label desc.FieldDescriptorProto_Label, ok bool) {
+	switch p := d.(type) {
+	case *desc.FieldDescriptorProto:
+		if p != nil && p.Type != nil {
+			return p.GetLabel(), true
+		}
+	case desc.FieldDescriptorProto_Label:
+		return p, true
+	}
+	return 0, false
 }
 
-func isUint32(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
-	}
-
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_UINT32
-	}
-
-	return false
+// fieldLabel extends the package-level fieldLabel so that fields whose
+// field_presence feature resolves to LEGACY_REQUIRED are reported as
+// required. Editions files have no required label and use the feature instead.
+func (t typeFinder) fieldLabel(d interface{}) (label desc.FieldDescriptorProto_Label, ok bool) {
+	label, ok = fieldLabel(d)
+	if p, isField := d.(*desc.FieldDescriptorProto); ok && isField && label == desc.FieldDescriptorProto_LABEL_OPTIONAL {
+		if t.fieldPresence(p) == desc.FeatureSet_LEGACY_REQUIRED {
+			label = desc.FieldDescriptorProto_LABEL_REQUIRED
+		}
+	}
+	return label, ok
 }
 
-func isEnum(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
-	}
-
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_ENUM
+// typeCheck returns a function that returns true if its argument has one of
+// the given field types.
+func typeCheck(types ...desc.FieldDescriptorProto_Type) func(interface{}) bool {
+	return func(d interface{}) bool {
+		typ, ok := fieldType(d)
+		if !ok {
+			return false
+		}
+		for _, t := range types {
+			if typ == t {
+				return true
+			}
+		}
+		return false
 	}
-
-	_, ok := d.(*desc.EnumDescriptorProto)
-	return ok
 }
 
-func isSfixed32(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
+func labelCheck(label desc.FieldDescriptorProto_Label) func(interface{}) bool {
+	return func(d interface{}) bool {
+		l, ok := fieldLabel(d)
+		return ok && l == label
 	}
+}
 
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_SFIXED32
+// labelCheck extends the package-level labelCheck to resolve required fields
+// through the field_presence feature (see typeFinder.fieldLabel).
+func (t typeFinder) labelCheck(label desc.FieldDescriptorProto_Label) func(interface{}) bool {
+	return func(d interface{}) bool {
+		l, ok := t.fieldLabel(d)
+		return ok && l == label
 	}
-
-	return false
 }
 
-func isSfixed64(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
-	}
+var (
+	isRepeated = labelCheck(desc.FieldDescriptorProto_LABEL_REPEATED)
+
+	isDouble   = typeCheck(desc.FieldDescriptorProto_TYPE_DOUBLE)
+	isFloat    = typeCheck(desc.FieldDescriptorProto_TYPE_FLOAT)
+	isInt64    = typeCheck(desc.FieldDescriptorProto_TYPE_INT64)
+	isUint64   = typeCheck(desc.FieldDescriptorProto_TYPE_UINT64)
+	isInt32    = typeCheck(desc.FieldDescriptorProto_TYPE_INT32)
+	isFixed64  = typeCheck(desc.FieldDescriptorProto_TYPE_FIXED64)
+	isFixed32  = typeCheck(desc.FieldDescriptorProto_TYPE_FIXED32)
+	isBool     = typeCheck(desc.FieldDescriptorProto_TYPE_BOOL)
+	isString   = typeCheck(desc.FieldDescriptorProto_TYPE_STRING)
+	isGroup    = typeCheck(desc.FieldDescriptorProto_TYPE_GROUP)
+	isMessage  = typeCheck(desc.FieldDescriptorProto_TYPE_MESSAGE)
+	isBytes    = typeCheck(desc.FieldDescriptorProto_TYPE_BYTES)
+	isUint32   = typeCheck(desc.FieldDescriptorProto_TYPE_UINT32)
+	isEnum     = typeCheck(desc.FieldDescriptorProto_TYPE_ENUM)
+	isSfixed32 = typeCheck(desc.FieldDescriptorProto_TYPE_SFIXED32)
+	isSfixed64 = typeCheck(desc.FieldDescriptorProto_TYPE_SFIXED64)
+	isSint32   = typeCheck(desc.FieldDescriptorProto_TYPE_SINT32)
+	isSint64   = typeCheck(desc.FieldDescriptorProto_TYPE_SINT64)
+
+	isFloating = typeCheck(
+		desc.FieldDescriptorProto_TYPE_DOUBLE,
+		desc.FieldDescriptorProto_TYPE_FLOAT,
+	)
+	isInteger = typeCheck(
+		desc.FieldDescriptorProto_TYPE_INT64,
+		desc.FieldDescriptorProto_TYPE_UINT64,
+		desc.FieldDescriptorProto_TYPE_INT32,
+		desc.FieldDescriptorProto_TYPE_UINT32,
+		desc.FieldDescriptorProto_TYPE_SINT32,
+		desc.FieldDescriptorProto_TYPE_SINT64,
+		desc.FieldDescriptorProto_TYPE_FIXED32,
+		desc.FieldDescriptorProto_TYPE_FIXED64,
+		desc.FieldDescriptorProto_TYPE_SFIXED32,
+		desc.FieldDescriptorProto_TYPE_SFIXED64,
+	)
+	// isSigned is true for numeric types that can hold negative values.
+	isSigned = typeCheck(
+		desc.FieldDescriptorProto_TYPE_DOUBLE,
+		desc.FieldDescriptorProto_TYPE_FLOAT,
+		desc.FieldDescriptorProto_TYPE_INT64,
+		desc.FieldDescriptorProto_TYPE_INT32,
+		desc.FieldDescriptorProto_TYPE_SINT32,
+		desc.FieldDescriptorProto_TYPE_SINT64,
+		desc.FieldDescriptorProto_TYPE_SFIXED32,
+		desc.FieldDescriptorProto_TYPE_SFIXED64,
+	)
+	isVarint = typeCheck(
+		desc.FieldDescriptorProto_TYPE_INT64,
+		desc.FieldDescriptorProto_TYPE_UINT64,
+		desc.FieldDescriptorProto_TYPE_INT32,
+		desc.FieldDescriptorProto_TYPE_UINT32,
+		desc.FieldDescriptorProto_TYPE_SINT32,
+		desc.FieldDescriptorProto_TYPE_SINT64,
+		desc.FieldDescriptorProto_TYPE_BOOL,
+		desc.FieldDescriptorProto_TYPE_ENUM,
+	)
+	isFixedWidth = typeCheck(
+		desc.FieldDescriptorProto_TYPE_DOUBLE,
+		desc.FieldDescriptorProto_TYPE_FLOAT,
+		desc.FieldDescriptorProto_TYPE_FIXED32,
+		desc.FieldDescriptorProto_TYPE_FIXED64,
+		desc.FieldDescriptorProto_TYPE_SFIXED32,
+		desc.FieldDescriptorProto_TYPE_SFIXED64,
+	)
+	isZigzag = typeCheck(
+		desc.FieldDescriptorProto_TYPE_SINT32,
+		desc.FieldDescriptorProto_TYPE_SINT64,
+	)
+)
 
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_SFIXED64
-	}
+func isNumeric(d interface{}) bool {
+	return isInteger(d) || isFloating(d)
+}
 
-	return false
+// isScalar returns true for the scalar value types: numbers, bools, strings
+// and bytes. Enums are not scalars.
+func isScalar(d interface{}) bool {
+	return isNumeric(d) || isBool(d) || isString(d) || isBytes(d)
 }
 
-func isSint32(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
+// kind returns the name of d's field type as written in .proto files (e.g.,
+// int32 or message).
+func kind(d interface{}) (string, error) {
+	typ, ok := fieldType(d)
+	if !ok {
+		return "", fmt.Errorf("kind: %T has no field type", d)
 	}
+	return strings.ToLower(strings.TrimPrefix(typ.String(), "TYPE_")), nil
+}
 
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_SINT32
+// label returns the name of d's field label as written in .proto files
+// (e.g., repeated). Fields that are required through the field_presence
+// feature are labeled required.
+func (t typeFinder) label(d interface{}) (string, error) {
+	l, ok := t.fieldLabel(d)
+	if !ok {
+		return "", fmt.Errorf("label: %T has no label", d)
 	}
-
-	return false
+	return strings.ToLower(strings.TrimPrefix(l.String(), "LABEL_")), nil
 }
 
-func isSint64(d interface{}) bool {
-	if p, ok := d.(*desc.FieldDescriptorProto); ok && p != nil && p.Type != nil {
-		d = p.GetType()
+// wireType returns the wire type used to encode d. For fields, this accounts
+// for packed repeated fields and message fields using delimited (group)
+// encoding. For anything else with a field type, it is the wire type of
+// single values of that type.
+func (t typeFinder) wireType(d interface{}) (int, error) {
+	if field, ok := d.(*desc.FieldDescriptorProto); ok && field != nil && field.Type != nil {
+		return t.fieldWireType(field), nil
 	}
 
-	if i, ok := d.(desc.FieldDescriptorProto_Type); ok {
-		return i == desc.FieldDescriptorProto_TYPE_SINT64
+	typ, ok := fieldType(d)
+	if !ok {
+		return 0, fmt.Errorf("wire_type: %T has no field type", d)
 	}
-
-	return false
+	return wireTypeOf(typ), nil
 }
@@ -0,0 +1,301 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+	compiler "github.com/nilium/pinktxt/internal/plugin/google/protobuf/compiler"
+)
+
+// symbolTable maps fully-qualified names, including their leading '.', to
+// the descriptors they name. Packages map to the first file declaring them.
+type symbolTable struct {
+	symbols map[string]interface{}
+	names   map[interface{}]string
+	parents map[interface{}]interface{}
+	// paths holds the SourceCodeInfo path of each descriptor, relative to
+	// its file. Files have an empty path.
+	paths map[interface{}][]int32
+	// locations holds SourceCodeInfo locations by file and path.
+	locations map[*desc.FileDescriptorProto]map[string]*desc.SourceCodeInfo_Location
+
+	// packages holds every package and parent package name declared by the
+	// files in the table (e.g., .foo and .foo.bar for package foo.bar).
+	packages map[string]bool
+	// enumValues holds enum values by their protobuf scope, which is the
+	// enum's parent rather than the enum itself.
+	enumValues map[string]*desc.EnumValueDescriptorProto
+}
+
+func newSymbolTable(files []*desc.FileDescriptorProto) *symbolTable {
+	s := &symbolTable{
+		symbols:    make(map[string]interface{}),
+		names:      make(map[interface{}]string),
+		parents:    make(map[interface{}]interface{}),
+		paths:      make(map[interface{}][]int32),
+		locations:  make(map[*desc.FileDescriptorProto]map[string]*desc.SourceCodeInfo_Location),
+		packages:   make(map[string]bool),
+		enumValues: make(map[string]*desc.EnumValueDescriptorProto),
+	}
+	for _, f := range files {
+		s.addFile(f)
+	}
+	return s
+}
+
+// add records d under name unless name is already taken. protoc rejects
+// duplicate symbols, so a conflict only occurs for packages spread across
+// several files. The parent of d and its SourceCodeInfo path are recorded as
+// well.
+func (s *symbolTable) add(name string, d, parent interface{}, path []int32) {
+	if _, ok := s.symbols[name]; !ok {
+		s.symbols[name] = d
+	}
+	if _, ok := s.names[d]; !ok {
+		s.names[d] = name
+	}
+	if parent != nil {
+		s.parents[d] = parent
+	}
+	s.paths[d] = path
+}
+
+func (s *symbolTable) addFile(f *desc.FileDescriptorProto) {
+	scope := "."
+	if pkg := f.GetPackage(); pkg != "" {
+		s.add("."+pkg, f, nil, nil)
+		scope += pkg + "."
+
+		for i := range pkg {
+			if pkg[i] == '.' {
+				s.packages["."+pkg[:i]] = true
+			}
+		}
+		s.packages["."+pkg] = true
+	} else {
+		s.names[f] = ""
+	}
+
+	s.addMessages(f.GetMessageType(), scope, f, []int32{pathFileMessageType})
+	s.addEnums(f.GetEnumType(), scope, f, []int32{pathFileEnumType})
+	s.addFields(f.GetExtension(), scope, f, []int32{pathFileExtension})
+	s.addServices(f.GetService(), scope, f, []int32{pathFileService})
+	s.addLocations(f)
+}
+
+// addMessages records messages and everything nested in them. Group types
+// are declared as nested messages and are recorded here as well.
+func (s *symbolTable) addMessages(in []*desc.DescriptorProto, scope string, parent interface{}, path []int32) {
+	for i, m := range in {
+		name := scope + m.GetName()
+		mpath := appendPath(path, i)
+		s.add(name, m, parent, mpath)
+
+		prefix := name + "."
+		s.addMessages(m.GetNestedType(), prefix, m, appendPath(mpath, pathMessageNestedType))
+		s.addEnums(m.GetEnumType(), prefix, m, appendPath(mpath, pathMessageEnumType))
+		s.addFields(m.GetExtension(), prefix, m, appendPath(mpath, pathMessageExtension))
+		s.addFields(m.GetField(), prefix, m, appendPath(mpath, pathMessageField))
+		for j, o := range m.GetOneofDecl() {
+			s.add(prefix+o.GetName(), o, m, appendPath(mpath, pathMessageOneofDecl, j))
+		}
+	}
+}
+
+// addFields records fields and extensions. The parent of an extension is the
+// scope it's declared in, not the message it extends.
+func (s *symbolTable) addFields(in []*desc.FieldDescriptorProto, scope string, parent interface{}, path []int32) {
+	for i, f := range in {
+		s.add(scope+f.GetName(), f, parent, appendPath(path, i))
+	}
+}
+
+func (s *symbolTable) addEnums(in []*desc.EnumDescriptorProto, scope string, parent interface{}, path []int32) {
+	for i, e := range in {
+		name := scope + e.GetName()
+		epath := appendPath(path, i)
+		s.add(name, e, parent, epath)
+
+		for j, v := range e.GetValue() {
+			s.add(name+"."+v.GetName(), v, e, appendPath(epath, pathEnumValue, j))
+			if _, ok := s.enumValues[scope+v.GetName()]; !ok {
+				s.enumValues[scope+v.GetName()] = v
+			}
+		}
+	}
+}
+
+func (s *symbolTable) addServices(in []*desc.ServiceDescriptorProto, scope string, parent interface{}, path []int32) {
+	for i, svc := range in {
+		name := scope + svc.GetName()
+		spath := appendPath(path, i)
+		s.add(name, svc, parent, spath)
+
+		for j, m := range svc.GetMethod() {
+			s.add(name+"."+m.GetName(), m, svc, appendPath(spath, pathServiceMethod, j))
+		}
+	}
+}
+
+// lookup returns the descriptor for name using protobuf scoping, where
+// enum values are siblings of their enum. If name refers to a package with
+// no descriptor of its own (e.g., a parent package), lookup returns the
+// package name and true.
+func (s *symbolTable) lookup(name string) (interface{}, bool) {
+	if d, ok := s.symbols[name]; ok {
+		return d, true
+	}
+	if v, ok := s.enumValues[name]; ok {
+		return v, true
+	}
+	if s.packages[name] {
+		return name, true
+	}
+	return nil, false
+}
+
+type typeFinder struct {
+	Request *compiler.CodeGeneratorRequest
+
+	symbols *symbolTable
+}
+
+func newTypeFinder(req *compiler.CodeGeneratorRequest) typeFinder {
+	return typeFinder{
+		Request: req,
+		symbols: newSymbolTable(req.GetProtoFile()),
+	}
+}
+
+// Find returns the descriptor for the fully-qualified name, which must begin
+// with a '.'. Enum values are found as children of their enum (i.e.,
+// .pkg.Enum.VALUE). Fields and oneofs are found as children of their message.
+// If no descriptor is found, Find returns nil.
+func (t typeFinder) Find(name string) interface{} {
+	if name == "" || name[0] != '.' {
+		return nil
+	}
+
+	return t.symbols.symbols[name]
+}
+
+// parent returns the descriptor enclosing d: a message or file for
+// messages, enums and fields, an enum for enum values, a service for methods,
+// and a file for services. Files have no parent.
+func (t typeFinder) parent(d interface{}) interface{} {
+	d = unwrapOneof(d)
+	if !isHashable(d) {
+		return nil
+	}
+	return t.symbols.parents[d]
+}
+
+// fileOf returns the file that declares d.
+func (t typeFinder) fileOf(d interface{}) *desc.FileDescriptorProto {
+	for d != nil {
+		if f, ok := d.(*desc.FileDescriptorProto); ok {
+			return f
+		}
+		d = t.parent(d)
+	}
+	return nil
+}
+
+// fullName returns the fully-qualified name of d, including its leading '.'
+// (i.e., the name d would be found by using Find). The full name of a file
+// is its package.
+func (t typeFinder) fullName(d interface{}) string {
+	d = unwrapOneof(d)
+	if !isHashable(d) {
+		return ""
+	}
+	return t.symbols.names[d]
+}
+
+// packageOf returns the package of the file that declares d.
+func (t typeFinder) packageOf(d interface{}) string {
+	return t.fileOf(d).GetPackage()
+}
+
+func isHashable(d interface{}) bool {
+	return d != nil && reflect.TypeOf(d).Comparable()
+}
+
+// resolve returns the descriptor for name relative to scope, which may be
+// any descriptor known to the finder (typically a message or file). As with
+// protoc, the scope and each of its parents are searched in turn for the
+// first component of name. If that component is found but the remainder of
+// name cannot be found inside it, resolution fails rather than continuing
+// outward. Fully-qualified names are looked up as-is.
+func (t typeFinder) resolve(name string, scope interface{}) (interface{}, error) {
+	if strings.HasPrefix(name, ".") {
+		if d := t.Find(name); d != nil {
+			return d, nil
+		}
+		return nil, fmt.Errorf("%s: symbol not found", name)
+	}
+
+	scope = unwrapOneof(scope)
+	var from string
+	switch s := scope.(type) {
+	case *desc.FileDescriptorProto:
+		if pkg := s.GetPackage(); pkg != "" {
+			from = "." + pkg
+		}
+	default:
+		n, ok := "", false
+		if isHashable(scope) {
+			n, ok = t.symbols.names[scope]
+		}
+		if !ok {
+			return nil, fmt.Errorf("%s: cannot resolve in unknown scope %T", name, scope)
+		}
+		from = n
+	}
+
+	first, rest := name, ""
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		first, rest = name[:i], name[i:]
+	}
+
+	for scope := from; ; {
+		candidate := scope + "." + first
+		if d, ok := t.symbols.lookup(candidate); ok {
+			if rest == "" {
+				if _, isPkg := d.(string); !isPkg {
+					return d, nil
+				}
+			} else if isAggregate(d) {
+				if d, ok := t.symbols.lookup(candidate + rest); ok {
+					if _, isPkg := d.(string); !isPkg {
+						return d, nil
+					}
+				}
+				return nil, fmt.Errorf("%s: %s%s not found (resolving from %s)", name, candidate[1:], rest, from)
+			}
+		}
+
+		if scope == "" {
+			break
+		}
+		scope = scope[:strings.LastIndexByte(scope, '.')]
+	}
+
+	if from == "" {
+		from = "root scope"
+	}
+	return nil, fmt.Errorf("%s: symbol not found (resolving from %s)", name, from)
+}
+
+// isAggregate returns true if d is a descriptor that can contain other
+// symbols, or a package name.
+func isAggregate(d interface{}) bool {
+	switch d.(type) {
+	case string, *desc.FileDescriptorProto, *desc.DescriptorProto,
+		*desc.EnumDescriptorProto, *desc.ServiceDescriptorProto:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+	compiler "github.com/nilium/pinktxt/internal/plugin/google/protobuf/compiler"
+)
+
+func testFinderRequest() *compiler.CodeGeneratorRequest {
+	return &compiler.CodeGeneratorRequest{
+		ProtoFile: []*desc.FileDescriptorProto{
+			{
+				Name:    proto.String("foo/a.proto"),
+				Package: proto.String("foo.bar"),
+				MessageType: []*desc.DescriptorProto{
+					{
+						Name: proto.String("Outer"),
+						NestedType: []*desc.DescriptorProto{
+							{
+								Name: proto.String("Middle"),
+								NestedType: []*desc.DescriptorProto{
+									{Name: proto.String("Inner")},
+								},
+								EnumType: []*desc.EnumDescriptorProto{
+									{
+										Name: proto.String("Kind"),
+										Value: []*desc.EnumValueDescriptorProto{
+											{Name: proto.String("KIND_A"), Number: proto.Int32(0)},
+										},
+									},
+								},
+							},
+							{Name: proto.String("Group")},
+						},
+						Field: []*desc.FieldDescriptorProto{
+							{
+								Name:     proto.String("group"),
+								Number:   proto.Int32(1),
+								Type:     desc.FieldDescriptorProto_TYPE_GROUP.Enum(),
+								TypeName: proto.String(".foo.bar.Outer.Group"),
+							},
+						},
+						Extension: []*desc.FieldDescriptorProto{
+							{Name: proto.String("nested_ext"), Number: proto.Int32(100)},
+						},
+					},
+				},
+				EnumType: []*desc.EnumDescriptorProto{
+					{
+						Name: proto.String("Top"),
+						Value: []*desc.EnumValueDescriptorProto{
+							{Name: proto.String("TOP_A"), Number: proto.Int32(0)},
+						},
+					},
+				},
+				Extension: []*desc.FieldDescriptorProto{
+					{Name: proto.String("top_ext"), Number: proto.Int32(101)},
+				},
+				Service: []*desc.ServiceDescriptorProto{
+					{
+						Name: proto.String("Svc"),
+						Method: []*desc.MethodDescriptorProto{
+							{Name: proto.String("Call")},
+						},
+					},
+				},
+			},
+			{
+				Name:    proto.String("foo/b.proto"),
+				Package: proto.String("foo.bar"),
+				MessageType: []*desc.DescriptorProto{
+					{Name: proto.String("Second")},
+				},
+			},
+			{
+				Name:    proto.String("foo/c.proto"),
+				Package: proto.String("foo.barbaz"),
+				MessageType: []*desc.DescriptorProto{
+					{Name: proto.String("Outer")},
+				},
+			},
+			{
+				Name: proto.String("nopkg.proto"),
+				MessageType: []*desc.DescriptorProto{
+					{Name: proto.String("Bare")},
+				},
+			},
+		},
+	}
+}
+
+func TestTypeFinderFind(t *testing.T) {
+	req := testFinderRequest()
+	a, b, c, nopkg := req.ProtoFile[0], req.ProtoFile[1], req.ProtoFile[2], req.ProtoFile[3]
+	outer := a.MessageType[0]
+	middle := outer.NestedType[0]
+
+	cases := []struct {
+		name string
+		want interface{}
+	}{
+		{".foo.bar", a},
+		{".foo.bar.Outer", outer},
+		{".foo.bar.Outer.Middle", middle},
+		{".foo.bar.Outer.Middle.Inner", middle.NestedType[0]},
+		{".foo.bar.Outer.Middle.Kind", middle.EnumType[0]},
+		{".foo.bar.Outer.Middle.Kind.KIND_A", middle.EnumType[0].Value[0]},
+		{".foo.bar.Outer.Group", outer.NestedType[1]},
+		{".foo.bar.Outer.nested_ext", outer.Extension[0]},
+		{".foo.bar.Top", a.EnumType[0]},
+		{".foo.bar.Top.TOP_A", a.EnumType[0].Value[0]},
+		{".foo.bar.top_ext", a.Extension[0]},
+		{".foo.bar.Svc", a.Service[0]},
+		{".foo.bar.Svc.Call", a.Service[0].Method[0]},
+		{".foo.bar.Second", b.MessageType[0]},
+		{".foo.barbaz", c},
+		{".foo.barbaz.Outer", c.MessageType[0]},
+		{".Bare", nopkg.MessageType[0]},
+	}
+
+	finder := newTypeFinder(req)
+	for _, c := range cases {
+		if got := finder.Find(c.name); got != c.want {
+			t.Errorf("Find(%q) = %v; want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestTypeFinderFindMissing(t *testing.T) {
+	finder := newTypeFinder(testFinderRequest())
+	for _, name := range []string{
+		"",
+		"foo.bar.Outer",
+		".foo",
+		".foo.ba",
+		".foo.bar.Middle",
+		".foo.bar.Outer.Inner",
+		".foo.barbaz.Second",
+		".foo.bar.Outer.Middle.KIND_A",
+		".Outer",
+	} {
+		if got := finder.Find(name); got != nil {
+			t.Errorf("Find(%q) = %v; want nil", name, got)
+		}
+	}
+}
+
+func TestTypeFinderResolve(t *testing.T) {
+	req := testFinderRequest()
+	a, b, c := req.ProtoFile[0], req.ProtoFile[1], req.ProtoFile[2]
+	outer := a.MessageType[0]
+	middle := outer.NestedType[0]
+	inner := middle.NestedType[0]
+
+	cases := []struct {
+		name  string
+		scope interface{}
+		want  interface{}
+	}{
+		{"Outer", a, outer},
+		{"Second", a, b.MessageType[0]},
+		{"Outer.Middle", b, middle},
+		{"bar.Outer", a, outer},
+		{"foo.bar.Top", c, a.EnumType[0]},
+		{"Outer", c, c.MessageType[0]},
+		{"bar.Outer", c, outer},
+		{"Inner", middle, inner},
+		{"Middle.Inner", inner, inner},
+		{"Kind", inner, middle.EnumType[0]},
+		{"KIND_A", inner, middle.EnumType[0].Value[0]},
+		{"TOP_A", outer, a.EnumType[0].Value[0]},
+		{"Svc.Call", outer, a.Service[0].Method[0]},
+		{".foo.barbaz.Outer", middle, c.MessageType[0]},
+	}
+
+	finder := newTypeFinder(req)
+	for _, c := range cases {
+		got, err := finder.resolve(c.name, c.scope)
+		if err != nil {
+			t.Errorf("resolve(%q) error = %v", c.name, err)
+		} else if got != c.want {
+			t.Errorf("resolve(%q) = %v; want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestTypeFinderResolveErrors(t *testing.T) {
+	req := testFinderRequest()
+	a, c := req.ProtoFile[0], req.ProtoFile[2]
+	middle := a.MessageType[0].NestedType[0]
+
+	cases := []struct {
+		name  string
+		scope interface{}
+	}{
+		{"Inner", a},
+		{"Second", c},
+		// Outer is found in foo.barbaz first, so foo.bar.Outer's nested
+		// types are not considered.
+		{"Outer.Middle", c},
+		{"Middle.Missing", middle},
+		{".foo.bar.Missing", a},
+		{"Outer", &desc.DescriptorProto{}},
+	}
+
+	finder := newTypeFinder(req)
+	for _, c := range cases {
+		if got, err := finder.resolve(c.name, c.scope); err == nil {
+			t.Errorf("resolve(%q) = %v; want error", c.name, got)
+		}
+	}
+}
+
+func TestTypeFinderScopes(t *testing.T) {
+	req := testFinderRequest()
+	a, b := req.ProtoFile[0], req.ProtoFile[1]
+	outer := a.MessageType[0]
+	middle := outer.NestedType[0]
+	kind := middle.EnumType[0]
+	svc := a.Service[0]
+
+	cases := []struct {
+		d        interface{}
+		parent   interface{}
+		fullName string
+	}{
+		{a, nil, ".foo.bar"},
+		{b, nil, ".foo.bar"},
+		{outer, a, ".foo.bar.Outer"},
+		{middle, outer, ".foo.bar.Outer.Middle"},
+		{middle.NestedType[0], middle, ".foo.bar.Outer.Middle.Inner"},
+		{kind, middle, ".foo.bar.Outer.Middle.Kind"},
+		{kind.Value[0], kind, ".foo.bar.Outer.Middle.Kind.KIND_A"},
+		{outer.Field[0], outer, ".foo.bar.Outer.group"},
+		{outer.Extension[0], outer, ".foo.bar.Outer.nested_ext"},
+		{a.Extension[0], a, ".foo.bar.top_ext"},
+		{svc, a, ".foo.bar.Svc"},
+		{svc.Method[0], svc, ".foo.bar.Svc.Call"},
+		{b.MessageType[0], b, ".foo.bar.Second"},
+	}
+
+	finder := newTypeFinder(req)
+	for _, c := range cases {
+		if got := finder.parent(c.d); got != c.parent {
+			t.Errorf("parent(%s) = %v; want %v", c.fullName, got, c.parent)
+		}
+		if got := finder.fullName(c.d); got != c.fullName {
+			t.Errorf("fullName(%v) = %q; want %q", c.d, got, c.fullName)
+		}
+		if got := finder.packageOf(c.d); got != "foo.bar" {
+			t.Errorf("packageOf(%s) = %q; want %q", c.fullName, got, "foo.bar")
+		}
+	}
+
+	if got := finder.fileOf(kind.Value[0]); got != a {
+		t.Errorf("fileOf(KIND_A) = %v; want %v", got, a)
+	}
+	if got := finder.fileOf(b.MessageType[0]); got != b {
+		t.Errorf("fileOf(Second) = %v; want %v", got, b)
+	}
+	if got := finder.fileOf(&desc.DescriptorProto{}); got != nil {
+		t.Errorf("fileOf(unknown) = %v; want nil", got)
+	}
+}
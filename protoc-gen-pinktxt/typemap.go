@@ -0,0 +1,454 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"text/template"
+
+	yaml "gopkg.in/yaml.v2"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+)
+
+// TypeMap describes how protobuf field types are written in a target
+// language. Formats are fmt strings taking the element type (and, for maps,
+// the key type first).
+type TypeMap struct {
+	Name string `yaml:"name" json:"name"`
+	// Base is the name of a type map that unset entries are taken from.
+	Base string `yaml:"base,omitempty" json:"base,omitempty"`
+
+	// Scalars maps scalar type names, as written in .proto files (e.g.,
+	// int32 or bytes), to target types.
+	Scalars map[string]string `yaml:"scalars,omitempty" json:"scalars,omitempty"`
+	// Boxed maps target scalar types to the type used when they're wrapped
+	// by Repeated, Map or Optional (e.g., int to Integer in Java).
+	Boxed map[string]string `yaml:"boxed,omitempty" json:"boxed,omitempty"`
+	// Types maps fully-qualified message and enum names to target types,
+	// overriding the usual qualified name (e.g., .google.protobuf.Timestamp
+	// to time.Time).
+	Types map[string]string `yaml:"types,omitempty" json:"types,omitempty"`
+
+	Repeated string `yaml:"repeated,omitempty" json:"repeated,omitempty"`
+	Map      string `yaml:"map,omitempty" json:"map,omitempty"`
+	Optional string `yaml:"optional,omitempty" json:"optional,omitempty"`
+	// OptionalExcept lists scalar types, named as in Scalars, that aren't
+	// wrapped by Optional because their target type already has an unset
+	// value (e.g., bytes in Go, where nil is unset).
+	OptionalExcept []string `yaml:"optional_except,omitempty" json:"optional_except,omitempty"`
+	// Message is the format of any reference to a message type, while
+	// SingularMessage is applied to non-repeated message fields only.
+	Message         string `yaml:"message,omitempty" json:"message,omitempty"`
+	SingularMessage string `yaml:"singular_message,omitempty" json:"singular_message,omitempty"`
+	Enum            string `yaml:"enum,omitempty" json:"enum,omitempty"`
+
+	// NestedSeparator joins the names of nested types.
+	NestedSeparator string `yaml:"nested_separator,omitempty" json:"nested_separator,omitempty"`
+	// Package is how types from other packages are qualified: "full" for the
+	// full package, "last" for its last component, or "none".
+	Package          string `yaml:"package,omitempty" json:"package,omitempty"`
+	PackageSeparator string `yaml:"package_separator,omitempty" json:"package_separator,omitempty"`
+
+	// base is the name of the built-in type map that m was derived from, if
+	// any.
+	base string
+}
+
+var builtinTypeMaps = map[string]*TypeMap{
+	"go": {
+		Name: "go",
+		Scalars: map[string]string{
+			"double": "float64", "float": "float32",
+			"int64": "int64", "uint64": "uint64", "int32": "int32", "uint32": "uint32",
+			"sint32": "int32", "sint64": "int64",
+			"fixed64": "uint64", "fixed32": "uint32", "sfixed32": "int32", "sfixed64": "int64",
+			"bool": "bool", "string": "string", "bytes": "[]byte",
+		},
+		Repeated:         "[]%s",
+		Map:              "map[%s]%s",
+		Optional:         "*%s",
+		OptionalExcept:   []string{"bytes"},
+		Message:          "*%s",
+		NestedSeparator:  "_",
+		Package:          "last",
+		PackageSeparator: ".",
+	},
+	"typescript": {
+		Name: "typescript",
+		Scalars: map[string]string{
+			"double": "number", "float": "number",
+			"int64": "bigint", "uint64": "bigint", "int32": "number", "uint32": "number",
+			"sint32": "number", "sint64": "bigint",
+			"fixed64": "bigint", "fixed32": "number", "sfixed32": "number", "sfixed64": "bigint",
+			"bool": "boolean", "string": "string", "bytes": "Uint8Array",
+		},
+		Repeated:         "%s[]",
+		Map:              "Map<%s, %s>",
+		Optional:         "%s | undefined",
+		SingularMessage:  "%s | undefined",
+		NestedSeparator:  "_",
+		Package:          "none",
+		PackageSeparator: ".",
+	},
+	"python": {
+		Name: "python",
+		Scalars: map[string]string{
+			"double": "float", "float": "float",
+			"int64": "int", "uint64": "int", "int32": "int", "uint32": "int",
+			"sint32": "int", "sint64": "int",
+			"fixed64": "int", "fixed32": "int", "sfixed32": "int", "sfixed64": "int",
+			"bool": "bool", "string": "str", "bytes": "bytes",
+		},
+		Repeated:         "list[%s]",
+		Map:              "dict[%s, %s]",
+		Optional:         "Optional[%s]",
+		NestedSeparator:  ".",
+		Package:          "none",
+		PackageSeparator: ".",
+	},
+	"java": {
+		Name: "java",
+		Scalars: map[string]string{
+			"double": "double", "float": "float",
+			"int64": "long", "uint64": "long", "int32": "int", "uint32": "int",
+			"sint32": "int", "sint64": "long",
+			"fixed64": "long", "fixed32": "int", "sfixed32": "int", "sfixed64": "long",
+			"bool": "boolean", "string": "String", "bytes": "com.google.protobuf.ByteString",
+		},
+		Boxed: map[string]string{
+			"double": "Double", "float": "Float", "long": "Long", "int": "Integer", "boolean": "Boolean",
+		},
+		Repeated:         "java.util.List<%s>",
+		Map:              "java.util.Map<%s, %s>",
+		Optional:         "java.util.Optional<%s>",
+		NestedSeparator:  ".",
+		Package:          "full",
+		PackageSeparator: ".",
+	},
+	"rust": {
+		Name: "rust",
+		Scalars: map[string]string{
+			"double": "f64", "float": "f32",
+			"int64": "i64", "uint64": "u64", "int32": "i32", "uint32": "u32",
+			"sint32": "i32", "sint64": "i64",
+			"fixed64": "u64", "fixed32": "u32", "sfixed32": "i32", "sfixed64": "i64",
+			"bool": "bool", "string": "String", "bytes": "Vec<u8>",
+		},
+		Repeated:         "Vec<%s>",
+		Map:              "std::collections::HashMap<%s, %s>",
+		Optional:         "Option<%s>",
+		SingularMessage:  "Option<%s>",
+		NestedSeparator:  "::",
+		Package:          "full",
+		PackageSeparator: "::",
+	},
+	"csharp": {
+		Name: "csharp",
+		Scalars: map[string]string{
+			"double": "double", "float": "float",
+			"int64": "long", "uint64": "ulong", "int32": "int", "uint32": "uint",
+			"sint32": "int", "sint64": "long",
+			"fixed64": "ulong", "fixed32": "uint", "sfixed32": "int", "sfixed64": "long",
+			"bool": "bool", "string": "string", "bytes": "Google.Protobuf.ByteString",
+		},
+		Repeated:         "Google.Protobuf.Collections.RepeatedField<%s>",
+		Map:              "Google.Protobuf.Collections.MapField<%s, %s>",
+		Optional:         "%s?",
+		NestedSeparator:  ".Types.",
+		Package:          "full",
+		PackageSeparator: ".",
+	},
+	"swift": {
+		Name: "swift",
+		Scalars: map[string]string{
+			"double": "Double", "float": "Float",
+			"int64": "Int64", "uint64": "UInt64", "int32": "Int32", "uint32": "UInt32",
+			"sint32": "Int32", "sint64": "Int64",
+			"fixed64": "UInt64", "fixed32": "UInt32", "sfixed32": "Int32", "sfixed64": "Int64",
+			"bool": "Bool", "string": "String", "bytes": "Data",
+		},
+		Repeated:         "[%s]",
+		Map:              "[%s: %s]",
+		Optional:         "%s?",
+		NestedSeparator:  ".",
+		Package:          "none",
+		PackageSeparator: "_",
+	},
+	"kotlin": {
+		Name: "kotlin",
+		Scalars: map[string]string{
+			"double": "Double", "float": "Float",
+			"int64": "Long", "uint64": "Long", "int32": "Int", "uint32": "Int",
+			"sint32": "Int", "sint64": "Long",
+			"fixed64": "Long", "fixed32": "Int", "sfixed32": "Int", "sfixed64": "Long",
+			"bool": "Boolean", "string": "String", "bytes": "com.google.protobuf.ByteString",
+		},
+		Repeated:         "List<%s>",
+		Map:              "Map<%s, %s>",
+		Optional:         "%s?",
+		NestedSeparator:  ".",
+		Package:          "full",
+		PackageSeparator: ".",
+	},
+}
+
+var typeMapAliases = map[string]string{
+	"golang": "go",
+	"ts":     "typescript",
+	"py":     "python",
+	"rs":     "rust",
+	"cs":     "csharp",
+	"c#":     "csharp",
+	"kt":     "kotlin",
+}
+
+// TypeMaps holds the type maps available to templates by name.
+type TypeMaps struct {
+	maps map[string]*TypeMap
+	// def is the name of the type map used when none is given.
+	def string
+}
+
+// loadTypeMaps returns the built-in type maps along with those read from
+// files, which may be YAML or JSON. Type maps from files replace built-in
+// type maps of the same name.
+func loadTypeMaps(def string, files []string) (*TypeMaps, error) {
+	t := &TypeMaps{
+		maps: make(map[string]*TypeMap, len(builtinTypeMaps)+len(files)),
+		def:  def,
+	}
+	for name, m := range builtinTypeMaps {
+		t.maps[name] = m
+	}
+
+	for _, file := range files {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("error reading type map: %v", err)
+		}
+
+		var m TypeMap
+		if err := yaml.UnmarshalStrict(b, &m); err != nil {
+			return nil, fmt.Errorf("error parsing type map %s: %v", file, err)
+		}
+		if m.Name == "" {
+			return nil, fmt.Errorf("type map %s has no name", file)
+		}
+
+		if m.Base != "" {
+			base, err := t.Get(m.Base)
+			if err != nil {
+				return nil, fmt.Errorf("type map %s: %v", file, err)
+			}
+			m.inherit(base)
+			m.base = base.literals()
+		}
+		t.maps[strings.ToLower(m.Name)] = &m
+	}
+
+	return t, nil
+}
+
+// inherit copies any entries unset in m from base.
+func (m *TypeMap) inherit(base *TypeMap) {
+	mergeStrings := func(dst *map[string]string, src map[string]string) {
+		if len(src) == 0 {
+			return
+		}
+		merged := make(map[string]string, len(src)+len(*dst))
+		for k, v := range src {
+			merged[k] = v
+		}
+		for k, v := range *dst {
+			merged[k] = v
+		}
+		*dst = merged
+	}
+	mergeStrings(&m.Scalars, base.Scalars)
+	mergeStrings(&m.Boxed, base.Boxed)
+	mergeStrings(&m.Types, base.Types)
+	if m.OptionalExcept == nil {
+		m.OptionalExcept = base.OptionalExcept
+	}
+
+	for _, f := range []struct{ dst, src *string }{
+		{&m.Repeated, &base.Repeated},
+		{&m.Map, &base.Map},
+		{&m.Optional, &base.Optional},
+		{&m.Message, &base.Message},
+		{&m.SingularMessage, &base.SingularMessage},
+		{&m.Enum, &base.Enum},
+		{&m.NestedSeparator, &base.NestedSeparator},
+		{&m.Package, &base.Package},
+		{&m.PackageSeparator, &base.PackageSeparator},
+	} {
+		if *f.dst == "" {
+			*f.dst = *f.src
+		}
+	}
+}
+
+// Get returns the type map with the given name. If name is empty, the
+// default type map is returned.
+func (t *TypeMaps) Get(name string) (*TypeMap, error) {
+	if name == "" {
+		name = t.def
+	}
+	if name == "" {
+		return nil, fmt.Errorf("no type map given and no default type map set")
+	}
+
+	name = strings.ToLower(name)
+	if alias, ok := typeMapAliases[name]; ok {
+		if _, ok := t.maps[name]; !ok {
+			name = alias
+		}
+	}
+	if m, ok := t.maps[name]; ok {
+		return m, nil
+	}
+	return nil, fmt.Errorf("type map %q not found", name)
+}
+
+func mergeTypeMaps(funcs template.FuncMap, types typeFinder, maps *TypeMaps) template.FuncMap {
+	if funcs == nil {
+		funcs = make(template.FuncMap)
+	}
+
+	funcs["typemap"] = maps.Get
+	funcs["lang_type"] = func(lang string, field *desc.FieldDescriptorProto) (string, error) {
+		m, err := maps.Get(lang)
+		if err != nil {
+			return "", err
+		}
+		return types.langType(m, field)
+	}
+	return funcs
+}
+
+func wrapType(format, typ string) string {
+	if format == "" {
+		return typ
+	}
+	return fmt.Sprintf(format, typ)
+}
+
+// langType returns the target type of field in the type map m, including
+// any repeated, map or optional wrapping.
+func (t typeFinder) langType(m *TypeMap, field *desc.FieldDescriptorProto) (string, error) {
+	if entry := t.mapEntry(field); entry != nil {
+		key, err := t.elemType(m, t.mapKey(entry), true)
+		if err != nil {
+			return "", err
+		}
+		val, err := t.elemType(m, t.mapValue(entry), true)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(m.Map, key, val), nil
+	}
+
+	repeated := field.GetLabel() == desc.FieldDescriptorProto_LABEL_REPEATED
+	optional := t.hasOptionalType(m, field)
+	typ, err := t.elemType(m, field, repeated || optional)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case repeated:
+		return wrapType(m.Repeated, typ), nil
+	case isMessage(field) || isGroup(field):
+		return wrapType(m.SingularMessage, typ), nil
+	case optional:
+		return wrapType(m.Optional, typ), nil
+	}
+	return typ, nil
+}
+
+// hasOptionalType returns true if the scalar or enum field has explicit
+// presence, and so is written using m's optional format. Scalars listed in
+// m's OptionalExcept never are.
+func (t typeFinder) hasOptionalType(m *TypeMap, field *desc.FieldDescriptorProto) bool {
+	if field.GetLabel() != desc.FieldDescriptorProto_LABEL_OPTIONAL || isMessage(field) || isGroup(field) {
+		return false
+	}
+	if isOneOf(field) {
+		return false
+	}
+	scalar := strings.TrimPrefix(strings.ToLower(field.GetType().String()), "type_")
+	for _, except := range m.OptionalExcept {
+		if except == scalar {
+			return false
+		}
+	}
+	return t.fieldPresence(field) == desc.FeatureSet_EXPLICIT
+}
+
+// elemType returns the target type of a single value of field. If boxed is
+// true, scalars are replaced by their boxed types.
+func (t typeFinder) elemType(m *TypeMap, field *desc.FieldDescriptorProto, boxed bool) (string, error) {
+	if field == nil {
+		return "", fmt.Errorf("type map %s: missing map key or value", m.Name)
+	}
+
+	switch {
+	case isMessage(field) || isGroup(field):
+		name, err := t.qualifiedType(m, field)
+		return wrapType(m.Message, name), err
+	case isEnum(field):
+		name, err := t.qualifiedType(m, field)
+		return wrapType(m.Enum, name), err
+	}
+
+	scalar := strings.TrimPrefix(strings.ToLower(field.GetType().String()), "type_")
+	typ, ok := m.Scalars[scalar]
+	if !ok {
+		return "", fmt.Errorf("type map %s: no type for %s", m.Name, scalar)
+	}
+	if b, ok := m.Boxed[typ]; ok && boxed {
+		typ = b
+	}
+	return typ, nil
+}
+
+// qualifiedType returns the target name of field's message or enum type. The
+// type's package is included only if it differs from the field's package.
+func (t typeFinder) qualifiedType(m *TypeMap, field *desc.FieldDescriptorProto) (string, error) {
+	return t.qualifiedName(m, field.GetTypeName(), t.packageOf(field))
+}
+
+// qualifiedName returns the target name of the type with the fully-qualified
+// name, as seen from the package from.
+func (t typeFinder) qualifiedName(m *TypeMap, name, from string) (string, error) {
+	if typ, ok := m.Types[name]; ok {
+		return typ, nil
+	}
+
+	d := t.Find(name)
+	if d == nil {
+		return "", fmt.Errorf("type map %s: type %s not found", m.Name, name)
+	}
+
+	pkg := t.packageOf(d)
+	local := strings.TrimPrefix(name, "."+pkg+".")
+	if pkg == "" {
+		local = strings.TrimPrefix(name, ".")
+	}
+	local = strings.Replace(local, ".", m.NestedSeparator, -1)
+
+	if pkg == "" || pkg == from {
+		return local, nil
+	}
+
+	switch m.Package {
+	case "full":
+		pkg = strings.Replace(pkg, ".", m.PackageSeparator, -1)
+	case "last":
+		pkg = pkg[strings.LastIndexByte(pkg, '.')+1:]
+	default:
+		return local, nil
+	}
+	return pkg + m.PackageSeparator + local, nil
+}
@@ -0,0 +1,337 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+	compiler "github.com/nilium/pinktxt/internal/plugin/google/protobuf/compiler"
+)
+
+func TestLangTypePresence(t *testing.T) {
+	const (
+		unset    = desc.FeatureSet_FIELD_PRESENCE_UNKNOWN
+		explicit = desc.FeatureSet_EXPLICIT
+		implicit = desc.FeatureSet_IMPLICIT
+		required = desc.FeatureSet_LEGACY_REQUIRED
+
+		optional = desc.FieldDescriptorProto_LABEL_OPTIONAL
+		repeated = desc.FieldDescriptorProto_LABEL_REPEATED
+		labelReq = desc.FieldDescriptorProto_LABEL_REQUIRED
+	)
+
+	p2opt := testPresenceField("p2opt", optional, unset)
+	p2req := testPresenceField("p2req", labelReq, unset)
+	p3plain := testPresenceField("p3plain", optional, unset)
+	p3opt := testPresenceField("p3opt", optional, unset)
+	p3opt.Proto3Optional = proto.Bool(true)
+	p3opt.OneofIndex = proto.Int32(0)
+	p3oneof := testPresenceField("p3oneof", optional, unset)
+	p3oneof.OneofIndex = proto.Int32(1)
+	edPlain := testPresenceField("ed_plain", optional, unset)
+	edReq := testPresenceField("ed_req", optional, required)
+	edImplicit := testPresenceField("ed_implicit", optional, implicit)
+	edList := testPresenceField("ed_list", repeated, unset)
+	fileImplicit := testPresenceField("file_implicit", optional, unset)
+	fileOverride := testPresenceField("file_override", optional, explicit)
+	p2bytes := testPresenceField("p2bytes", optional, unset)
+	p2bytes.Type = desc.FieldDescriptorProto_TYPE_BYTES.Enum()
+	edBytes := testPresenceField("ed_bytes", optional, unset)
+	edBytes.Type = desc.FieldDescriptorProto_TYPE_BYTES.Enum()
+
+	p3 := testPresenceFile("p3.proto", "proto3", unset, p3plain, p3opt, p3oneof)
+	p3.MessageType[0].OneofDecl = []*desc.OneofDescriptorProto{
+		{Name: proto.String("_p3opt")},
+		{Name: proto.String("choice")},
+	}
+	req := &compiler.CodeGeneratorRequest{
+		ProtoFile: []*desc.FileDescriptorProto{
+			testPresenceFile("p2.proto", "proto2", unset, p2opt, p2req, p2bytes),
+			p3,
+			testPresenceFile("ed.proto", "editions", unset, edPlain, edReq, edImplicit, edList, edBytes),
+			testPresenceFile("edimp.proto", "editions", implicit, fileImplicit, fileOverride),
+		},
+	}
+
+	cases := []struct {
+		field *desc.FieldDescriptorProto
+		want  string
+	}{
+		{p2opt, "*int32"},
+		{p2req, "int32"},
+		{p3plain, "int32"},
+		{p3opt, "*int32"},
+		{p3oneof, "int32"},
+		{edPlain, "*int32"},
+		{edReq, "int32"},
+		{edImplicit, "int32"},
+		{edList, "[]int32"},
+		{fileImplicit, "int32"},
+		{fileOverride, "*int32"},
+		// A nil []byte is already unset.
+		{p2bytes, "[]byte"},
+		{edBytes, "[]byte"},
+	}
+
+	finder := newTypeFinder(req)
+	m := builtinTypeMaps["go"]
+	for _, c := range cases {
+		got, err := finder.langType(m, c.field)
+		if err != nil {
+			t.Errorf("langType(go, %s) error = %v", c.field.GetName(), err)
+		} else if got != c.want {
+			t.Errorf("langType(go, %s) = %q; want %q", c.field.GetName(), got, c.want)
+		}
+	}
+}
+
+func testTypeMapRequest() (*compiler.CodeGeneratorRequest, map[string]*desc.FieldDescriptorProto) {
+	const (
+		optional = desc.FieldDescriptorProto_LABEL_OPTIONAL
+		repeated = desc.FieldDescriptorProto_LABEL_REPEATED
+
+		tInt32   = desc.FieldDescriptorProto_TYPE_INT32
+		tInt64   = desc.FieldDescriptorProto_TYPE_INT64
+		tString  = desc.FieldDescriptorProto_TYPE_STRING
+		tEnum    = desc.FieldDescriptorProto_TYPE_ENUM
+		tMessage = desc.FieldDescriptorProto_TYPE_MESSAGE
+	)
+
+	field := func(name string, num int32, label desc.FieldDescriptorProto_Label, typ desc.FieldDescriptorProto_Type, typeName string) *desc.FieldDescriptorProto {
+		f := testWireField(name, num, label, typ)
+		if typeName != "" {
+			f.TypeName = proto.String(typeName)
+		}
+		return f
+	}
+	entry := func(name string, key, val *desc.FieldDescriptorProto) *desc.DescriptorProto {
+		return &desc.DescriptorProto{
+			Name:    proto.String(name),
+			Field:   []*desc.FieldDescriptorProto{key, val},
+			Options: &desc.MessageOptions{MapEntry: proto.Bool(true)},
+		}
+	}
+
+	fields := map[string]*desc.FieldDescriptorProto{
+		"num":     field("num", 1, optional, tInt32, ""),
+		"names":   field("names", 2, repeated, tString, ""),
+		"nums":    field("nums", 3, repeated, tInt32, ""),
+		"counts":  field("counts", 4, repeated, tMessage, ".pkg.Holder.CountsEntry"),
+		"inners":  field("inners", 5, repeated, tMessage, ".pkg.Holder.InnersEntry"),
+		"inner":   field("inner", 6, optional, tMessage, ".pkg.Outer.Inner"),
+		"kind":    field("kind", 7, optional, tEnum, ".pkg.Kind"),
+		"remote":  field("remote", 8, optional, tMessage, ".a.other.Remote.Sub"),
+		"stamp":   field("stamp", 9, optional, tMessage, ".google.protobuf.Timestamp"),
+		"missing": field("missing", 10, optional, tMessage, ".pkg.Missing"),
+	}
+	holder := &desc.DescriptorProto{
+		Name: proto.String("Holder"),
+		NestedType: []*desc.DescriptorProto{
+			entry("CountsEntry", field("key", 1, optional, tString, ""), field("value", 2, optional, tInt32, "")),
+			entry("InnersEntry", field("key", 1, optional, tInt64, ""), field("value", 2, optional, tMessage, ".pkg.Outer.Inner")),
+		},
+	}
+	for _, name := range []string{"num", "names", "nums", "counts", "inners", "inner", "kind", "remote", "stamp", "missing"} {
+		holder.Field = append(holder.Field, fields[name])
+	}
+
+	req := &compiler.CodeGeneratorRequest{
+		ProtoFile: []*desc.FileDescriptorProto{
+			{
+				Name:        proto.String("google/protobuf/timestamp.proto"),
+				Package:     proto.String("google.protobuf"),
+				Syntax:      proto.String("proto3"),
+				MessageType: []*desc.DescriptorProto{{Name: proto.String("Timestamp")}},
+			},
+			{
+				Name:    proto.String("other.proto"),
+				Package: proto.String("a.other"),
+				Syntax:  proto.String("proto3"),
+				MessageType: []*desc.DescriptorProto{
+					{Name: proto.String("Remote"), NestedType: []*desc.DescriptorProto{{Name: proto.String("Sub")}}},
+				},
+			},
+			{
+				Name:     proto.String("pkg.proto"),
+				Package:  proto.String("pkg"),
+				Syntax:   proto.String("proto3"),
+				EnumType: []*desc.EnumDescriptorProto{{Name: proto.String("Kind")}},
+				MessageType: []*desc.DescriptorProto{
+					{Name: proto.String("Outer"), NestedType: []*desc.DescriptorProto{{Name: proto.String("Inner")}}},
+					holder,
+				},
+			},
+		},
+	}
+	return req, fields
+}
+
+func TestLangType(t *testing.T) {
+	type types map[string]string
+	cases := []struct {
+		field string
+		want  types
+	}{
+		{"num", types{
+			"go": "int32", "typescript": "number", "python": "int", "java": "int",
+			"rust": "i32", "csharp": "int", "swift": "Int32", "kotlin": "Int",
+		}},
+		{"names", types{
+			"go":         "[]string",
+			"typescript": "string[]",
+			"python":     "list[str]",
+			"java":       "java.util.List<String>",
+			"rust":       "Vec<String>",
+			"csharp":     "Google.Protobuf.Collections.RepeatedField<string>",
+			"swift":      "[String]",
+			"kotlin":     "List<String>",
+		}},
+		// Scalars are boxed inside containers.
+		{"nums", types{"java": "java.util.List<Integer>", "kotlin": "List<Int>", "go": "[]int32"}},
+		{"counts", types{
+			"go":         "map[string]int32",
+			"typescript": "Map<string, number>",
+			"python":     "dict[str, int]",
+			"java":       "java.util.Map<String, Integer>",
+			"rust":       "std::collections::HashMap<String, i32>",
+			"csharp":     "Google.Protobuf.Collections.MapField<string, int>",
+			"swift":      "[String: Int32]",
+		}},
+		{"inners", types{
+			"go":         "map[int64]*Outer_Inner",
+			"typescript": "Map<bigint, Outer_Inner>",
+			"java":       "java.util.Map<Long, Outer.Inner>",
+			"rust":       "std::collections::HashMap<i64, Outer::Inner>",
+			"csharp":     "Google.Protobuf.Collections.MapField<long, Outer.Types.Inner>",
+		}},
+		{"inner", types{
+			"go":         "*Outer_Inner",
+			"typescript": "Outer_Inner | undefined",
+			"python":     "Outer.Inner",
+			"java":       "Outer.Inner",
+			"rust":       "Option<Outer::Inner>",
+			"csharp":     "Outer.Types.Inner",
+			"swift":      "Outer.Inner",
+		}},
+		{"kind", types{"go": "Kind", "java": "Kind", "rust": "Kind"}},
+		// Types from other packages are qualified by the map's Package.
+		{"remote", types{
+			"go":         "*other.Remote_Sub",
+			"typescript": "Remote_Sub | undefined",
+			"python":     "Remote.Sub",
+			"java":       "a.other.Remote.Sub",
+			"rust":       "Option<a::other::Remote::Sub>",
+			"csharp":     "a.other.Remote.Types.Sub",
+			"swift":      "Remote.Sub",
+			"kotlin":     "a.other.Remote.Sub",
+		}},
+	}
+
+	req, fields := testTypeMapRequest()
+	finder := newTypeFinder(req)
+	for _, c := range cases {
+		for lang, want := range c.want {
+			got, err := finder.langType(builtinTypeMaps[lang], fields[c.field])
+			if err != nil {
+				t.Errorf("langType(%s, %s) error = %v", lang, c.field, err)
+			} else if got != want {
+				t.Errorf("langType(%s, %s) = %q; want %q", lang, c.field, got, want)
+			}
+		}
+	}
+
+	if got, err := finder.langType(builtinTypeMaps["go"], fields["missing"]); err == nil {
+		t.Errorf("langType(go, missing) = %q; want error", got)
+	}
+}
+
+func TestLoadTypeMaps(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		file := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return file
+	}
+
+	mygo := write("mygo.yaml", `name: MyGo
+base: golang
+scalars:
+  bytes: Bytes
+types:
+  .google.protobuf.Timestamp: time.Time
+nested_separator: __
+`)
+	py2 := write("py2.json", `{"name": "py2", "base": "py", "repeated": "List[%s]", "package": "full"}`)
+	java := write("java.yaml", "name: java\nscalars: {int32: Int32}\n")
+
+	maps, err := loadTypeMaps("mygo", []string{mygo, py2, java})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, fields := testTypeMapRequest()
+	finder := newTypeFinder(req)
+	cases := []struct {
+		lang, field, want string
+	}{
+		// Types and nested_separator are set; everything else is inherited.
+		{"", "stamp", "*time.Time"},
+		{"mygo", "inner", "*Outer__Inner"},
+		{"MYGO", "num", "int32"},
+		{"mygo", "counts", "map[string]int32"},
+		{"py2", "names", "List[str]"},
+		{"py2", "counts", "dict[str, int]"},
+		{"py2", "remote", "a.other.Remote.Sub"},
+		// Type maps from files replace built-in maps without a base.
+		{"java", "num", "Int32"},
+		{"go", "stamp", "*protobuf.Timestamp"},
+	}
+	for _, c := range cases {
+		m, err := maps.Get(c.lang)
+		if err != nil {
+			t.Errorf("Get(%q) error = %v", c.lang, err)
+			continue
+		}
+		got, err := finder.langType(m, fields[c.field])
+		if err != nil {
+			t.Errorf("langType(%q, %s) error = %v", c.lang, c.field, err)
+		} else if got != c.want {
+			t.Errorf("langType(%q, %s) = %q; want %q", c.lang, c.field, got, c.want)
+		}
+	}
+
+	if m, _ := maps.Get("mygo"); m.Scalars["bytes"] != "Bytes" || m.literals() != "go" || len(m.OptionalExcept) == 0 {
+		t.Errorf("mygo = %+v; want bytes Bytes, go literals and Go's optional_except", m)
+	}
+	if m, _ := maps.Get("java"); m.Scalars["string"] != "" || m.Repeated != "" {
+		t.Errorf("java = %+v; want only the file's entries", m)
+	}
+
+	for _, files := range [][]string{
+		{filepath.Join(dir, "nope.yaml")},
+		{write("bad.yaml", "name: [\n")},
+		{write("unknown.yaml", "name: x\nbogus: 1\n")},
+		{write("noname.yaml", "base: go\n")},
+		{write("nobase.yaml", "name: x\nbase: nope\n")},
+	} {
+		if _, err := loadTypeMaps("", files); err == nil {
+			t.Errorf("loadTypeMaps(%s) succeeded; want error", filepath.Base(files[0]))
+		}
+	}
+
+	if m, err := maps.Get("nope"); err == nil {
+		t.Errorf("Get(nope) = %v; want error", m.Name)
+	}
+	noDefault, err := loadTypeMaps("", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m, err := noDefault.Get(""); err == nil {
+		t.Errorf("Get(\"\") with no default = %v; want error", m.Name)
+	}
+}
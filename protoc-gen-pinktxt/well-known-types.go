@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+)
+
+const wktPrefix = ".google.protobuf."
+
+// wellKnownTypes holds the fully-qualified names of the well-known types.
+var wellKnownTypes = map[string]bool{
+	wktPrefix + "Any":           true,
+	wktPrefix + "Api":           true,
+	wktPrefix + "Method":        true,
+	wktPrefix + "Mixin":         true,
+	wktPrefix + "Duration":      true,
+	wktPrefix + "Empty":         true,
+	wktPrefix + "FieldMask":     true,
+	wktPrefix + "SourceContext": true,
+	wktPrefix + "Struct":        true,
+	wktPrefix + "Value":         true,
+	wktPrefix + "ListValue":     true,
+	wktPrefix + "NullValue":     true,
+	wktPrefix + "Timestamp":     true,
+	wktPrefix + "Type":          true,
+	wktPrefix + "Field":         true,
+	wktPrefix + "Enum":          true,
+	wktPrefix + "EnumValue":     true,
+	wktPrefix + "Option":        true,
+	wktPrefix + "Syntax":        true,
+	wktPrefix + "DoubleValue":   true,
+	wktPrefix + "FloatValue":    true,
+	wktPrefix + "Int64Value":    true,
+	wktPrefix + "UInt64Value":   true,
+	wktPrefix + "Int32Value":    true,
+	wktPrefix + "UInt32Value":   true,
+	wktPrefix + "BoolValue":     true,
+	wktPrefix + "StringValue":   true,
+	wktPrefix + "BytesValue":    true,
+}
+
+// wrapperTypes maps wrapper type names to the type of their value field.
+var wrapperTypes = map[string]desc.FieldDescriptorProto_Type{
+	wktPrefix + "DoubleValue": desc.FieldDescriptorProto_TYPE_DOUBLE,
+	wktPrefix + "FloatValue":  desc.FieldDescriptorProto_TYPE_FLOAT,
+	wktPrefix + "Int64Value":  desc.FieldDescriptorProto_TYPE_INT64,
+	wktPrefix + "UInt64Value": desc.FieldDescriptorProto_TYPE_UINT64,
+	wktPrefix + "Int32Value":  desc.FieldDescriptorProto_TYPE_INT32,
+	wktPrefix + "UInt32Value": desc.FieldDescriptorProto_TYPE_UINT32,
+	wktPrefix + "BoolValue":   desc.FieldDescriptorProto_TYPE_BOOL,
+	wktPrefix + "StringValue": desc.FieldDescriptorProto_TYPE_STRING,
+	wktPrefix + "BytesValue":  desc.FieldDescriptorProto_TYPE_BYTES,
+}
+
+// wktName returns the fully-qualified type name of d if it names a
+// well-known type. d may be a field, a message or enum descriptor, or a type
+// name. Fields and names must refer to a type found by find.
+func (t typeFinder) wktName(d interface{}) string {
+	var name string
+	switch p := d.(type) {
+	case *desc.FieldDescriptorProto:
+		name = p.GetTypeName()
+	case *desc.DescriptorProto, *desc.EnumDescriptorProto:
+		return t.wktFullName(d)
+	case string:
+		name = p
+		if !strings.HasPrefix(name, ".") {
+			name = "." + name
+		}
+	default:
+		return ""
+	}
+
+	if !wellKnownTypes[name] {
+		return ""
+	}
+	return t.wktFullName(t.Find(name))
+}
+
+func (t typeFinder) wktFullName(d interface{}) string {
+	switch d.(type) {
+	case *desc.DescriptorProto, *desc.EnumDescriptorProto:
+	default:
+		return ""
+	}
+	if name := t.fullName(d); wellKnownTypes[name] {
+		return name
+	}
+	return ""
+}
+
+func (t typeFinder) isWKT(d interface{}) bool {
+	return t.wktName(d) != ""
+}
+
+func (t typeFinder) isWKTNamed(name string) func(interface{}) bool {
+	name = wktPrefix + name
+	return func(d interface{}) bool {
+		return t.wktName(d) == name
+	}
+}
+
+func (t typeFinder) isWrapper(d interface{}) bool {
+	_, ok := wrapperTypes[t.wktName(d)]
+	return ok
+}
+
+// wrapperInnerType returns the type of the value field of the wrapper type
+// named by d.
+func (t typeFinder) wrapperInnerType(d interface{}) (desc.FieldDescriptorProto_Type, error) {
+	name := t.wktName(d)
+	typ, ok := wrapperTypes[name]
+	if !ok {
+		return 0, fmt.Errorf("wrapper_inner_type: %v is not a wrapper type", d)
+	}
+
+	if m, ok := t.Find(name).(*desc.DescriptorProto); ok {
+		for _, f := range m.GetField() {
+			if f.GetName() == "value" {
+				return f.GetType(), nil
+			}
+		}
+	}
+	return typ, nil
+}
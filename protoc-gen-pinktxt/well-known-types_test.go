@@ -0,0 +1,131 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+	compiler "github.com/nilium/pinktxt/internal/plugin/google/protobuf/compiler"
+)
+
+func TestWellKnownTypes(t *testing.T) {
+	msg := func(name string) *desc.DescriptorProto {
+		return &desc.DescriptorProto{Name: proto.String(name)}
+	}
+	wrapper := func(name string, typ desc.FieldDescriptorProto_Type) *desc.DescriptorProto {
+		m := msg(name)
+		m.Field = []*desc.FieldDescriptorProto{testWireField("value", 1, desc.FieldDescriptorProto_LABEL_OPTIONAL, typ)}
+		return m
+	}
+	wktFile := func(name string, msgs ...*desc.DescriptorProto) *desc.FileDescriptorProto {
+		return &desc.FileDescriptorProto{
+			Name:        proto.String("google/protobuf/" + name),
+			Package:     proto.String("google.protobuf"),
+			Syntax:      proto.String("proto3"),
+			MessageType: msgs,
+		}
+	}
+
+	timestamp := msg("Timestamp")
+	nullValue := &desc.EnumDescriptorProto{Name: proto.String("NullValue")}
+	int64Value := wrapper("Int64Value", desc.FieldDescriptorProto_TYPE_INT64)
+	// The value field's declared type is preferred over the expected one.
+	bytesValue := wrapper("BytesValue", desc.FieldDescriptorProto_TYPE_STRING)
+	structs := wktFile("struct.proto", msg("Struct"), msg("Value"), msg("ListValue"))
+	structs.EnumType = []*desc.EnumDescriptorProto{nullValue}
+	custom := msg("Custom")
+	notWKT := msg("Timestamp")
+	stampField := &desc.FieldDescriptorProto{
+		Name:     proto.String("stamp"),
+		Type:     desc.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+		TypeName: proto.String(".google.protobuf.Timestamp"),
+	}
+
+	req := &compiler.CodeGeneratorRequest{
+		ProtoFile: []*desc.FileDescriptorProto{
+			wktFile("any.proto", msg("Any")),
+			wktFile("duration.proto", msg("Duration")),
+			wktFile("empty.proto", msg("Empty")),
+			wktFile("field_mask.proto", msg("FieldMask")),
+			wktFile("timestamp.proto", timestamp),
+			wktFile("wrappers.proto", wrapper("DoubleValue", desc.FieldDescriptorProto_TYPE_DOUBLE), int64Value, bytesValue),
+			wktFile("custom.proto", custom),
+			structs,
+			{
+				Name:        proto.String("mine.proto"),
+				Package:     proto.String("mine"),
+				MessageType: []*desc.DescriptorProto{notWKT},
+			},
+		},
+	}
+
+	cases := []struct {
+		d    interface{}
+		name string
+		// pred is the is_ function (other than is_wkt) that's true for d.
+		pred string
+	}{
+		{"google.protobuf.Any", ".google.protobuf.Any", "is_any"},
+		{".google.protobuf.Duration", ".google.protobuf.Duration", "is_duration"},
+		{"google.protobuf.Empty", ".google.protobuf.Empty", "is_empty"},
+		{"google.protobuf.FieldMask", ".google.protobuf.FieldMask", "is_field_mask"},
+		{"google.protobuf.Struct", ".google.protobuf.Struct", "is_struct"},
+		{"google.protobuf.Value", ".google.protobuf.Value", "is_value"},
+		{"google.protobuf.ListValue", ".google.protobuf.ListValue", "is_list_value"},
+		{nullValue, ".google.protobuf.NullValue", "is_null_value"},
+		{timestamp, ".google.protobuf.Timestamp", "is_timestamp"},
+		{stampField, ".google.protobuf.Timestamp", "is_timestamp"},
+		{"google.protobuf.DoubleValue", ".google.protobuf.DoubleValue", "is_wrapper"},
+		{int64Value, ".google.protobuf.Int64Value", "is_wrapper"},
+		{"google.protobuf.BytesValue", ".google.protobuf.BytesValue", "is_wrapper"},
+		// Names and types that aren't well-known types, or that aren't
+		// in the request.
+		{"google.protobuf.Custom", "", ""},
+		{custom, "", ""},
+		{notWKT, "", ""},
+		{"mine.Timestamp", "", ""},
+		{"google.protobuf.Api", "", ""},
+		{nil, "", ""},
+		{42, "", ""},
+	}
+
+	finder := newTypeFinder(req)
+	funcs := mergeTypeChecks(nil, finder)
+	preds := []string{
+		"is_any", "is_duration", "is_empty", "is_field_mask", "is_struct",
+		"is_value", "is_list_value", "is_null_value", "is_timestamp", "is_wrapper",
+	}
+	for _, c := range cases {
+		if got := finder.wktName(c.d); got != c.name {
+			t.Errorf("wktName(%v) = %q; want %q", c.d, got, c.name)
+		}
+		if got, want := funcs["is_wkt"].(func(interface{}) bool)(c.d), c.name != ""; got != want {
+			t.Errorf("is_wkt(%v) = %t; want %t", c.d, got, want)
+		}
+		for _, pred := range preds {
+			if got, want := funcs[pred].(func(interface{}) bool)(c.d), pred == c.pred; got != want {
+				t.Errorf("%s(%v) = %t; want %t", pred, c.d, got, want)
+			}
+		}
+	}
+
+	inner := []struct {
+		d    interface{}
+		want desc.FieldDescriptorProto_Type
+	}{
+		{"google.protobuf.DoubleValue", desc.FieldDescriptorProto_TYPE_DOUBLE},
+		{int64Value, desc.FieldDescriptorProto_TYPE_INT64},
+		{"google.protobuf.BytesValue", desc.FieldDescriptorProto_TYPE_STRING},
+	}
+	for _, c := range inner {
+		if got, err := finder.wrapperInnerType(c.d); err != nil || got != c.want {
+			t.Errorf("wrapperInnerType(%v) = %v, %v; want %v", c.d, got, err, c.want)
+		}
+	}
+	for _, d := range []interface{}{timestamp, "google.protobuf.BoolValue", "mine.Timestamp", nil} {
+		if got, err := finder.wrapperInnerType(d); err == nil {
+			t.Errorf("wrapperInnerType(%v) = %v; want error", d, got)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"text/template"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+)
+
+func mergeWireFormat(funcs template.FuncMap, types typeFinder) template.FuncMap {
+	if funcs == nil {
+		funcs = make(template.FuncMap)
+	}
+
+	funcs["field_tag"] = types.fieldTag
+	funcs["field_tag_bytes"] = types.fieldTagBytes
+	funcs["fixed_size"] = fixedSize
+	return funcs
+}
+
+// fieldWireType returns the wire type field is encoded with, accounting for
+// packed repeated fields and message fields using delimited (group) encoding.
+func (t typeFinder) fieldWireType(field *desc.FieldDescriptorProto) int {
+	if t.isPacked(field) {
+		return wireBytes
+	}
+
+	if field.GetType() == desc.FieldDescriptorProto_TYPE_MESSAGE {
+		for d := interface{}(field); d != nil; d = t.parent(d) {
+			enc := t.features(d).GetMessageEncoding()
+			if enc != desc.FeatureSet_MESSAGE_ENCODING_UNKNOWN {
+				if enc == desc.FeatureSet_DELIMITED {
+					return wireStartGroup
+				}
+				break
+			}
+		}
+	}
+
+	return wireTypeOf(field.GetType())
+}
+
+// fieldTag returns the tag preceding each encoded value of field: its number
+// and wire type.
+func (t typeFinder) fieldTag(field *desc.FieldDescriptorProto) (uint64, error) {
+	num := field.GetNumber()
+	if num < 1 || num > 1<<29-1 {
+		return 0, fmt.Errorf("field_tag %s: invalid field number %d", field.GetName(), num)
+	}
+	return uint64(num)<<3 | uint64(t.fieldWireType(field)), nil
+}
+
+// fieldTagBytes returns field's tag encoded as a varint.
+func (t typeFinder) fieldTagBytes(field *desc.FieldDescriptorProto) ([]byte, error) {
+	tag, err := t.fieldTag(field)
+	if err != nil {
+		return nil, err
+	}
+	return appendVarint(nil, tag), nil
+}
+
+func appendVarint(b []byte, x uint64) []byte {
+	for x >= 0x80 {
+		b = append(b, byte(x)|0x80)
+		x >>= 7
+	}
+	return append(b, byte(x))
+}
+
+// fixedSize returns the encoded size in bytes of single values of d's field
+// type, or 0 if values of the type vary in size.
+func fixedSize(d interface{}) (int, error) {
+	typ, ok := fieldType(d)
+	if !ok {
+		return 0, fmt.Errorf("fixed_size: %T has no field type", d)
+	}
+
+	if typ == desc.FieldDescriptorProto_TYPE_BOOL {
+		return 1, nil
+	}
+
+	switch wireTypeOf(typ) {
+	case wireFixed32:
+		return 4, nil
+	case wireFixed64:
+		return 8, nil
+	}
+	return 0, nil
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	desc "github.com/nilium/pinktxt/internal/plugin/google/protobuf"
+	compiler "github.com/nilium/pinktxt/internal/plugin/google/protobuf/compiler"
+)
+
+func testWireField(name string, num int32, label desc.FieldDescriptorProto_Label, typ desc.FieldDescriptorProto_Type) *desc.FieldDescriptorProto {
+	return &desc.FieldDescriptorProto{
+		Name:   proto.String(name),
+		Number: proto.Int32(num),
+		Label:  label.Enum(),
+		Type:   typ.Enum(),
+	}
+}
+
+func TestWireType(t *testing.T) {
+	const (
+		optional = desc.FieldDescriptorProto_LABEL_OPTIONAL
+		repeated = desc.FieldDescriptorProto_LABEL_REPEATED
+
+		tInt32   = desc.FieldDescriptorProto_TYPE_INT32
+		tFixed64 = desc.FieldDescriptorProto_TYPE_FIXED64
+		tString  = desc.FieldDescriptorProto_TYPE_STRING
+		tMessage = desc.FieldDescriptorProto_TYPE_MESSAGE
+		tGroup   = desc.FieldDescriptorProto_TYPE_GROUP
+	)
+
+	p2list := testWireField("p2list", 1, repeated, tInt32)
+	p2packed := testWireField("p2packed", 2, repeated, tInt32)
+	p2packed.Options = &desc.FieldOptions{Packed: proto.Bool(true)}
+	p2group := testWireField("p2group", 3, optional, tGroup)
+	p3list := testWireField("p3list", 1, repeated, tFixed64)
+	p3unpacked := testWireField("p3unpacked", 2, repeated, tFixed64)
+	p3unpacked.Options = &desc.FieldOptions{Packed: proto.Bool(false)}
+	p3strings := testWireField("p3strings", 3, repeated, tString)
+	p3msg := testWireField("p3msg", 16, optional, tMessage)
+	edList := testWireField("ed_list", 1, repeated, tInt32)
+	edExpanded := testWireField("ed_expanded", 2, repeated, tInt32)
+	edExpanded.Options = &desc.FieldOptions{
+		Features: &desc.FeatureSet{RepeatedFieldEncoding: desc.FeatureSet_EXPANDED.Enum()},
+	}
+	edMsg := testWireField("ed_msg", 3, optional, tMessage)
+	edDelimited := testWireField("ed_delimited", 4, optional, tMessage)
+	edDelimited.Options = &desc.FieldOptions{
+		Features: &desc.FeatureSet{MessageEncoding: desc.FeatureSet_DELIMITED.Enum()},
+	}
+	delimList := testWireField("delim_list", 1, repeated, tMessage)
+
+	req := &compiler.CodeGeneratorRequest{
+		ProtoFile: []*desc.FileDescriptorProto{
+			{
+				Name: proto.String("p2.proto"),
+				MessageType: []*desc.DescriptorProto{
+					{Name: proto.String("P2"), Field: []*desc.FieldDescriptorProto{p2list, p2packed, p2group}},
+				},
+			},
+			{
+				Name:   proto.String("p3.proto"),
+				Syntax: proto.String("proto3"),
+				MessageType: []*desc.DescriptorProto{
+					{Name: proto.String("P3"), Field: []*desc.FieldDescriptorProto{p3list, p3unpacked, p3strings, p3msg}},
+				},
+			},
+			{
+				Name:    proto.String("ed.proto"),
+				Syntax:  proto.String("editions"),
+				Edition: desc.Edition_EDITION_2023.Enum(),
+				MessageType: []*desc.DescriptorProto{
+					{Name: proto.String("Ed"), Field: []*desc.FieldDescriptorProto{edList, edExpanded, edMsg, edDelimited}},
+				},
+			},
+			{
+				Name:    proto.String("delim.proto"),
+				Syntax:  proto.String("editions"),
+				Edition: desc.Edition_EDITION_2023.Enum(),
+				Options: &desc.FileOptions{
+					Features: &desc.FeatureSet{MessageEncoding: desc.FeatureSet_DELIMITED.Enum()},
+				},
+				MessageType: []*desc.DescriptorProto{
+					{Name: proto.String("Delim"), Field: []*desc.FieldDescriptorProto{delimList}},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		d    interface{}
+		want int
+		tag  []byte
+	}{
+		{p2list, wireVarint, []byte{0x08}},
+		{p2packed, wireBytes, []byte{0x12}},
+		{p2group, wireStartGroup, []byte{0x1b}},
+		{p3list, wireBytes, []byte{0x0a}},
+		{p3unpacked, wireFixed64, []byte{0x11}},
+		{p3strings, wireBytes, []byte{0x1a}},
+		{p3msg, wireBytes, []byte{0x82, 0x01}},
+		{edList, wireBytes, []byte{0x0a}},
+		{edExpanded, wireVarint, []byte{0x10}},
+		{edMsg, wireBytes, []byte{0x1a}},
+		{edDelimited, wireStartGroup, []byte{0x23}},
+		{delimList, wireStartGroup, []byte{0x0b}},
+		// Field types give the wire type of single values.
+		{tInt32, wireVarint, nil},
+		{"fixed64", wireFixed64, nil},
+		{"group", wireStartGroup, nil},
+		{&desc.DescriptorProto{}, wireBytes, nil},
+	}
+
+	finder := newTypeFinder(req)
+	for _, c := range cases {
+		got, err := finder.wireType(c.d)
+		if err != nil {
+			t.Errorf("wireType(%v) error = %v", c.d, err)
+		} else if got != c.want {
+			t.Errorf("wireType(%v) = %d; want %d", c.d, got, c.want)
+		}
+
+		field, ok := c.d.(*desc.FieldDescriptorProto)
+		if !ok {
+			continue
+		}
+		if tag, err := finder.fieldTagBytes(field); err != nil {
+			t.Errorf("fieldTagBytes(%s) error = %v", field.GetName(), err)
+		} else if !bytes.Equal(tag, c.tag) {
+			t.Errorf("fieldTagBytes(%s) = % x; want % x", field.GetName(), tag, c.tag)
+		}
+	}
+
+	for _, d := range []interface{}{nil, "nope", &desc.FieldDescriptorProto{}} {
+		if got, err := finder.wireType(d); err == nil {
+			t.Errorf("wireType(%v) = %d; want error", d, got)
+		}
+	}
+}